@@ -0,0 +1,24 @@
+package goswag
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	chiWrapper "github.com/r0bertson/goswag/internal/frameworks/chi"
+	"github.com/r0bertson/goswag/models"
+)
+
+type Chi interface {
+	models.ChiRouter
+	http.Handler
+	models.Docs
+	// ValidationMiddleware returns a middleware answering the requests that do not match their documentation with a problem.
+	ValidationMiddleware(opts ...models.ValidationOptions) func(http.Handler) http.Handler
+	Router() chi.Router
+}
+
+// NewChi returns the interface that wraps the basic chi methods and add the swagger methods
+// defaultResponses is an optional parameter that can be used to set the default responses for all routes
+func NewChi(r chi.Router, defaultResponses ...models.ReturnType) Chi {
+	return chiWrapper.NewChi(r, defaultResponses...)
+}
@@ -0,0 +1,225 @@
+// Package contracttest checks in tests that the handlers return what their routes declare with Returns.
+//
+//	func TestUsers(t *testing.T) {
+//		r := goswag.NewEcho()
+//		registerRoutes(r)
+//
+//		c := contracttest.New(t, r)
+//		c.Do(httptest.NewRequest(http.MethodGet, "/users/1", nil))
+//	}
+//
+// The test fails when a documented route answers with a status code that is not declared
+// or with a json body that does not match the schema of the declared body. The documentation
+// is read when the checker is created, so the routes must be registered before.
+package contracttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/internal/validator"
+)
+
+// Router is implemented by the routers returned by goswag.
+type Router interface {
+	OpenAPI() ([]byte, error)
+}
+
+// Checker serves requests with the router and checks every response against the documentation.
+// It is an http.Handler, so it can also be used with httptest.NewServer.
+type Checker struct {
+	t       testing.TB
+	doc     *generator.Document
+	handler http.Handler
+}
+
+// New returns a checker that serves the requests with the handler of the router.
+func New(t testing.TB, router Router) *Checker {
+	t.Helper()
+
+	h, ok := routerHandler(router)
+	if !ok {
+		t.Fatalf("contracttest: the handler of %T is unknown, use NewHandler", router)
+	}
+
+	return NewHandler(t, router, h)
+}
+
+// NewHandler returns a checker that serves the requests with h, e.g. a mux wrapped by middlewares,
+// and checks the responses against the documentation of the router.
+func NewHandler(t testing.TB, router Router, h http.Handler) *Checker {
+	t.Helper()
+
+	content, err := router.OpenAPI()
+	if err != nil {
+		t.Fatalf("contracttest: building the documentation: %v", err)
+	}
+
+	var doc generator.Document
+	if err := json.Unmarshal(content, &doc); err != nil {
+		t.Fatalf("contracttest: reading the documentation: %v", err)
+	}
+
+	return &Checker{t: t, doc: &doc, handler: h}
+}
+
+// Do serves the request, checks the response and returns it.
+func (c *Checker) Do(r *http.Request) *httptest.ResponseRecorder {
+	c.t.Helper()
+
+	rec := httptest.NewRecorder()
+	c.handler.ServeHTTP(rec, r)
+	c.check(r, rec)
+
+	return rec
+}
+
+// ServeHTTP serves the request, checks the response and copies it to w.
+func (c *Checker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rec := c.Do(r)
+
+	for key, values := range rec.Header() {
+		w.Header()[key] = values
+	}
+
+	w.WriteHeader(rec.Code)
+	_, _ = w.Write(rec.Body.Bytes())
+}
+
+func (c *Checker) check(r *http.Request, rec *httptest.ResponseRecorder) {
+	c.t.Helper()
+
+	path, op := findOperation(c.doc, r.Method, r.URL.Path)
+	if op == nil {
+		// undocumented routes have nothing to check
+		return
+	}
+
+	route := r.Method + " " + path
+
+	resp, ok := op.Responses[strconv.Itoa(rec.Code)]
+	if !ok {
+		resp, ok = op.Responses["default"]
+	}
+
+	if !ok {
+		c.t.Errorf("contracttest: %s returned the undeclared status %d, declared: %s", route, rec.Code, declared(op))
+		return
+	}
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	schema := jsonSchema(resp, rec.Header().Get("Content-Type"))
+	if schema == nil {
+		return
+	}
+
+	body := bytes.TrimSpace(rec.Body.Bytes())
+	if len(body) == 0 {
+		c.t.Errorf("contracttest: %s returned %d with an empty body, a json body is declared", route, rec.Code)
+		return
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		c.t.Errorf("contracttest: %s returned %d with an invalid json body: %v", route, rec.Code, err)
+		return
+	}
+
+	for _, e := range validator.ValidateValue(c.doc.Resolve, schema, value, "body", "body") {
+		c.t.Errorf("contracttest: %s returned %d with a body that does not match the declared one: %s %s", route, rec.Code, e.Name, e.Reason)
+	}
+}
+
+// findOperation returns the operation of the documented path matching the request path.
+// When several paths match, the one with less parameters is the most specific.
+func findOperation(doc *generator.Document, method, requestPath string) (string, *generator.Operation) {
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var (
+		found     string
+		op        *generator.Operation
+		minParams int
+	)
+
+	for _, path := range paths {
+		candidate, ok := doc.Paths[path][strings.ToLower(method)]
+		if !ok {
+			continue
+		}
+
+		params, ok := validator.MatchPath(path, requestPath)
+		if !ok {
+			continue
+		}
+
+		if op == nil || len(params) < minParams {
+			found, op, minParams = path, candidate, len(params)
+		}
+	}
+
+	return found, op
+}
+
+// jsonSchema returns the schema of the json body of the response, nil when there is nothing to check.
+func jsonSchema(resp *generator.Response, contentType string) *generator.Schema {
+	if contentType != "" {
+		mediaType, _, _ := mime.ParseMediaType(contentType)
+		if !isJSON(mediaType) {
+			return nil
+		}
+	}
+
+	for mediaType, content := range resp.Content {
+		if isJSON(mediaType) {
+			return content.Schema
+		}
+	}
+
+	return nil
+}
+
+func isJSON(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+func declared(op *generator.Operation) string {
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	return strings.Join(codes, ", ")
+}
+
+// routerHandler returns the handler exposed by the echo, gin, fiber and net/http wrappers,
+// or the router itself for the wrappers that are handlers.
+func routerHandler(router Router) (http.Handler, bool) {
+	switch r := router.(type) {
+	case interface{ Handler() http.Handler }:
+		h := r.Handler()
+		return h, h != nil
+	case http.Handler:
+		return r, true
+	}
+
+	return nil, false
+}
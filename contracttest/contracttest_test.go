@@ -0,0 +1,143 @@
+package contracttest_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofiber/fiber/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/r0bertson/goswag"
+	"github.com/r0bertson/goswag/contracttest"
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder records the failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+type user struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type apiError struct {
+	Message string `json:"message"`
+}
+
+func TestChecker_Echo(t *testing.T) {
+	r := goswag.NewEcho(models.ReturnType{StatusCode: http.StatusInternalServerError, Body: apiError{}})
+	r.GET("/users/:id", func(c echo.Context) error {
+		switch c.Param("id") {
+		case "1":
+			return c.JSON(http.StatusOK, user{ID: 1, Name: "john"})
+		case "2":
+			return c.JSON(http.StatusOK, map[string]interface{}{"id": "2"})
+		case "3":
+			return c.NoContent(http.StatusTeapot)
+		case "4":
+			return c.JSON(http.StatusInternalServerError, apiError{Message: "boom"})
+		default:
+			return c.JSON(http.StatusNotFound, apiError{Message: "not found"})
+		}
+	}).Returns([]models.ReturnType{{StatusCode: http.StatusOK, Body: user{}}, {StatusCode: http.StatusNotFound, Body: apiError{}}})
+	r.GET("/users/me", func(c echo.Context) error { return c.String(http.StatusOK, "me") })
+	r.Echo().GET("/health", func(c echo.Context) error { return c.NoContent(http.StatusNoContent) })
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{path: "/users/1"},
+		{path: "/users/5"},
+		{path: "/users/4"},
+		{path: "/health"},
+		{
+			// the static route is more specific than /users/{id}, it only declares the default responses
+			path: "/users/me",
+			want: []string{"contracttest: GET /users/me returned the undeclared status 200, declared: 500"},
+		},
+		{
+			path: "/users/2",
+			want: []string{
+				"contracttest: GET /users/{id} returned 200 with a body that does not match the declared one: body.id must be an integer",
+				"contracttest: GET /users/{id} returned 200 with a body that does not match the declared one: body.name is required",
+			},
+		},
+		{
+			path: "/users/3",
+			want: []string{"contracttest: GET /users/{id} returned the undeclared status 418, declared: 200, 404, 500"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := &recorder{TB: t}
+			contracttest.New(rec, r).Do(httptest.NewRequest(http.MethodGet, tt.path, nil))
+			assert.ElementsMatch(t, tt.want, rec.errors)
+		})
+	}
+}
+
+func TestChecker_EchoGroup(t *testing.T) {
+	e := echo.New()
+	r := goswag.NewEchoGroup(e, e.Group("/api"))
+	r.GET("/users/:id", func(c echo.Context) error { return c.JSON(http.StatusOK, map[string]int{"id": 1}) }).
+		Returns([]models.ReturnType{{StatusCode: http.StatusOK, Body: user{}}})
+
+	rec := &recorder{TB: t}
+	resp := contracttest.New(rec, r).Do(httptest.NewRequest(http.MethodGet, "/api/users/1", nil))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []string{"contracttest: GET /api/users/{id} returned 200 with a body that does not match the declared one: body.name is required"}, rec.errors)
+}
+
+func TestChecker_Gin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := goswag.NewGin(gin.New())
+	r.POST("/users", func(c *gin.Context) { c.Status(http.StatusCreated) }).
+		Returns([]models.ReturnType{{StatusCode: http.StatusCreated, Body: user{}}})
+
+	rec := &recorder{TB: t}
+	contracttest.New(rec, r).Do(httptest.NewRequest(http.MethodPost, "/users", nil))
+	assert.Equal(t, []string{"contracttest: POST /users returned 201 with an empty body, a json body is declared"}, rec.errors)
+}
+
+func TestChecker_Fiber(t *testing.T) {
+	r := goswag.NewFiber(fiber.New())
+	r.Get("/users/:id", func(c *fiber.Ctx) error { return c.SendStatus(http.StatusNotFound) }).
+		Returns([]models.ReturnType{{StatusCode: http.StatusOK, Body: user{}}})
+
+	rec := &recorder{TB: t}
+	contracttest.New(rec, r).Do(httptest.NewRequest(http.MethodGet, "/users/1", nil))
+	assert.Equal(t, []string{"contracttest: GET /users/{id} returned the undeclared status 404, declared: 200"}, rec.errors)
+}
+
+func TestChecker_HTTP(t *testing.T) {
+	mux := http.NewServeMux()
+	r := goswag.NewHTTP(mux)
+	r.GET("/users", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":1,"name":"john"},{"id":"2","name":"jane"}]`))
+	}).Returns([]models.ReturnType{{StatusCode: http.StatusOK, Body: []user{}}})
+
+	rec := &recorder{TB: t}
+	server := httptest.NewServer(contracttest.New(rec, r))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/users")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.NoError(t, resp.Body.Close())
+	assert.Equal(t, []string{"contracttest: GET /users returned 200 with a body that does not match the declared one: body[1].id must be an integer"}, rec.errors)
+}
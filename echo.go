@@ -1,6 +1,8 @@
 package goswag
 
 import (
+	"net/http"
+
 	echoWrapper "github.com/r0bertson/goswag/internal/frameworks/echo"
 	"github.com/r0bertson/goswag/models"
 
@@ -9,8 +11,12 @@ import (
 
 type Echo interface {
 	models.EchoGroup
-	GenerateSwagger()
+	models.Docs
+	// ValidationMiddleware returns a middleware answering the requests that do not match their documentation with a problem.
+	ValidationMiddleware(opts ...models.ValidationOptions) echo.MiddlewareFunc
 	Echo() *echo.Echo
+	// Handler returns the http.Handler serving the routes, e.g. for httptest or contracttest.
+	Handler() http.Handler
 }
 
 // NewEcho returns the interface that wraps the basic Echo methods and add the swagger methods
@@ -18,3 +24,29 @@ type Echo interface {
 func NewEcho(defaultResponses ...models.ReturnType) Echo {
 	return echoWrapper.NewEcho(defaultResponses...)
 }
+
+// NewEchoFrom returns the same interface as NewEcho for an existing echo instance,
+// so its custom binder, validator, error handler and middlewares are kept.
+// defaultResponses is an optional parameter that can be used to set the default responses for all routes
+func NewEchoFrom(e *echo.Echo, defaultResponses ...models.ReturnType) Echo {
+	return echoWrapper.NewEchoFrom(e, defaultResponses...)
+}
+
+type EchoGroup interface {
+	models.EchoGroup
+	models.Docs
+	// ValidationMiddleware returns a middleware answering the requests that do not match their documentation with a problem.
+	ValidationMiddleware(opts ...models.ValidationOptions) echo.MiddlewareFunc
+	EchoGroup() *echo.Group
+	// Echo returns the echo instance that owns the group.
+	Echo() *echo.Echo
+	// Handler returns the http.Handler serving the routes, e.g. for httptest or contracttest.
+	Handler() http.Handler
+}
+
+// NewEchoGroup returns the interface that wraps an existing group of e and add the swagger methods,
+// the routes registered through it are documented with their full path and served by e.
+// defaultResponses is an optional parameter that can be used to set the default responses for all routes
+func NewEchoGroup(e *echo.Echo, g *echo.Group, defaultResponses ...models.ReturnType) EchoGroup {
+	return echoWrapper.NewEchoGroup(e, g, defaultResponses...)
+}
@@ -0,0 +1,23 @@
+package goswag
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	fiberWrapper "github.com/r0bertson/goswag/internal/frameworks/fiber"
+	"github.com/r0bertson/goswag/models"
+)
+
+type Fiber interface {
+	models.FiberRouter
+	models.Docs
+	App() *fiber.App
+	// Handler returns the http.Handler serving the routes, e.g. for httptest or contracttest.
+	Handler() http.Handler
+}
+
+// NewFiber returns the interface that wraps the basic fiber methods and add the swagger methods
+// defaultResponses is an optional parameter that can be used to set the default responses for all routes
+func NewFiber(app *fiber.App, defaultResponses ...models.ReturnType) Fiber {
+	return fiberWrapper.NewFiber(app, defaultResponses...)
+}
@@ -1,6 +1,8 @@
 package goswag
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 	ginWrapper "github.com/r0bertson/goswag/internal/frameworks/gin"
 	"github.com/r0bertson/goswag/models"
@@ -9,8 +11,12 @@ import (
 type Gin interface {
 	models.GinRouter
 	models.GinGroup
-	GenerateSwagger()
+	models.Docs
+	// ValidationMiddleware returns a middleware answering the requests that do not match their documentation with a problem.
+	ValidationMiddleware(opts ...models.ValidationOptions) gin.HandlerFunc
 	Gin() *gin.Engine
+	// Handler returns the http.Handler serving the routes, e.g. for httptest or contracttest.
+	Handler() http.Handler
 }
 
 // NewGin returns the interface that wraps the basic Gin methods and add the swagger methods
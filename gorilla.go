@@ -0,0 +1,24 @@
+package goswag
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	gorillaWrapper "github.com/r0bertson/goswag/internal/frameworks/gorilla"
+	"github.com/r0bertson/goswag/models"
+)
+
+type Gorilla interface {
+	models.GorillaRouter
+	http.Handler
+	models.Docs
+	// ValidationMiddleware returns a middleware answering the requests that do not match their documentation with a problem.
+	ValidationMiddleware(opts ...models.ValidationOptions) func(http.Handler) http.Handler
+	Router() *mux.Router
+}
+
+// NewGorilla returns the interface that wraps the basic gorilla/mux methods and add the swagger methods
+// defaultResponses is an optional parameter that can be used to set the default responses for all routes
+func NewGorilla(r *mux.Router, defaultResponses ...models.ReturnType) Gorilla {
+	return gorillaWrapper.NewGorilla(r, defaultResponses...)
+}
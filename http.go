@@ -10,8 +10,12 @@ import (
 type HTTP interface {
 	models.HTTPRouter
 	models.HTTPGroup
-	GenerateSwagger()
+	models.Docs
+	// ValidationMiddleware returns a middleware answering the requests that do not match their documentation with a problem.
+	ValidationMiddleware(opts ...models.ValidationOptions) func(http.Handler) http.Handler
 	Mux() *http.ServeMux
+	// Handler returns the http.Handler serving the routes, e.g. for httptest or contracttest.
+	Handler() http.Handler
 }
 
 // NewHTTP returns the interface that wraps the basic HTTP methods and add the swagger methods
@@ -0,0 +1,24 @@
+package goswag
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	httprouterWrapper "github.com/r0bertson/goswag/internal/frameworks/httprouter"
+	"github.com/r0bertson/goswag/models"
+)
+
+type HTTPRouter interface {
+	models.HttprouterRouter
+	http.Handler
+	models.Docs
+	// ValidationMiddleware returns a middleware answering the requests that do not match their documentation with a problem.
+	ValidationMiddleware(opts ...models.ValidationOptions) func(http.Handler) http.Handler
+	Router() *httprouter.Router
+}
+
+// NewHTTPRouter returns the interface that wraps the basic httprouter methods and add the swagger methods
+// defaultResponses is an optional parameter that can be used to set the default responses for all routes
+func NewHTTPRouter(router *httprouter.Router, defaultResponses ...models.ReturnType) HTTPRouter {
+	return httprouterWrapper.NewHTTPRouter(router, defaultResponses...)
+}
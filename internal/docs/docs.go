@@ -0,0 +1,95 @@
+package docs
+
+import (
+	"bytes"
+	"embed"
+	"html/template"
+	"net/http"
+	"strings"
+
+	"github.com/r0bertson/goswag/internal/generator"
+)
+
+// swaggerUIVersion and redocVersion are the pinned releases loaded by the pages.
+const (
+	swaggerUIVersion = "5.17.14"
+	redocVersion     = "2.1.5"
+)
+
+//go:embed swagger.html redoc.html
+var pages embed.FS
+
+var templates = template.Must(template.ParseFS(pages, "*.html"))
+
+// Paths are the paths served by Handler, relative to its prefix. The routers whose
+// catch-all routes conflict with the other routes register them one by one.
+var Paths = []string{"/", "/index.html", "/redoc", "/openapi.json", "/openapi.yaml"}
+
+// Handler returns the handler that serves the documentation under the prefix:
+//
+//	{prefix}              Swagger UI
+//	{prefix}/redoc        Redoc
+//	{prefix}/openapi.json the OpenAPI document in json
+//	{prefix}/openapi.yaml the OpenAPI document in yaml
+//
+// The spec function is called on every request for the document,
+// so routes registered after the handler is mounted are also documented.
+func Handler(prefix string, spec func() ([]byte, error)) http.Handler {
+	prefix = strings.TrimSuffix(prefix, "/")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch strings.TrimPrefix(r.URL.Path, prefix) {
+		case "", "/", "/index.html":
+			writePage(w, "swagger.html", prefix)
+		case "/redoc":
+			writePage(w, "redoc.html", prefix)
+		case "/openapi.json":
+			writeSpec(w, spec, "application/json", nil)
+		case "/openapi.yaml":
+			writeSpec(w, spec, "application/yaml", generator.JSONToYAML)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// page is the data of the templates, the assets are loaded from the pinned releases of the CDN.
+type page struct {
+	Prefix    string
+	SwaggerUI string
+	Redoc     string
+}
+
+func newPage(prefix string) page {
+	return page{
+		Prefix:    prefix,
+		SwaggerUI: "https://cdn.jsdelivr.net/npm/swagger-ui-dist@" + swaggerUIVersion,
+		Redoc:     "https://cdn.jsdelivr.net/npm/redoc@" + redocVersion + "/bundles",
+	}
+}
+
+func writePage(w http.ResponseWriter, name, prefix string) {
+	var b bytes.Buffer
+	if err := templates.ExecuteTemplate(&b, name, newPage(prefix)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(b.Bytes())
+}
+
+func writeSpec(w http.ResponseWriter, spec func() ([]byte, error), contentType string, convert func([]byte) ([]byte, error)) {
+	content, err := spec()
+	if err == nil && convert != nil {
+		content, err = convert(content)
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	_, _ = w.Write(content)
+}
@@ -0,0 +1,85 @@
+package docs
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler(t *testing.T) {
+	spec := func() ([]byte, error) {
+		return []byte(`{"openapi":"3.1.0","paths":{}}`), nil
+	}
+
+	tests := []struct {
+		name        string
+		path        string
+		status      int
+		contentType string
+		contains    string
+	}{
+		{name: "Should serve swagger ui", path: "/docs", status: http.StatusOK, contentType: "text/html; charset=utf-8", contains: `url: "\/docs/openapi.json"`},
+		{name: "Should serve swagger ui with trailing slash", path: "/docs/", status: http.StatusOK, contentType: "text/html; charset=utf-8", contains: "swagger-ui"},
+		{name: "Should serve redoc", path: "/docs/redoc", status: http.StatusOK, contentType: "text/html; charset=utf-8", contains: `spec-url="/docs/openapi.json"`},
+		{name: "Should serve the json document", path: "/docs/openapi.json", status: http.StatusOK, contentType: "application/json", contains: `"openapi":"3.1.0"`},
+		{name: "Should serve the yaml document", path: "/docs/openapi.yaml", status: http.StatusOK, contentType: "application/yaml", contains: "openapi: 3.1.0\npaths: {}\n"},
+		{name: "Should return not found for unknown paths", path: "/docs/unknown", status: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			Handler("/docs/", spec).ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+			if tt.contentType != "" {
+				assert.Equal(t, tt.contentType, w.Header().Get("Content-Type"))
+			}
+			assert.Contains(t, w.Body.String(), tt.contains)
+		})
+	}
+}
+
+func TestHandler_rootPrefix(t *testing.T) {
+	spec := func() ([]byte, error) {
+		return []byte(`{"openapi":"3.1.0","paths":{}}`), nil
+	}
+
+	for _, path := range Paths {
+		w := httptest.NewRecorder()
+		Handler("/", spec).ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code, path)
+	}
+
+	w := httptest.NewRecorder()
+	Handler("/", spec).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Contains(t, w.Body.String(), `url: "/openapi.json"`)
+}
+
+func TestHandler_pinnedAssets(t *testing.T) {
+	h := Handler("/docs", func() ([]byte, error) { return nil, nil })
+	serve := func(path string) string {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w.Body.String()
+	}
+
+	assert.Contains(t, serve("/docs"), "https://cdn.jsdelivr.net/npm/swagger-ui-dist@"+swaggerUIVersion+"/swagger-ui-bundle.js")
+	assert.Contains(t, serve("/docs/redoc"), "https://cdn.jsdelivr.net/npm/redoc@"+redocVersion+"/bundles/redoc.standalone.js")
+}
+
+func TestHandler_specError(t *testing.T) {
+	spec := func() ([]byte, error) {
+		return nil, errors.New("invalid spec")
+	}
+
+	w := httptest.NewRecorder()
+	Handler("/docs", spec).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs/openapi.yaml", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid spec")
+}
@@ -0,0 +1,439 @@
+package chi
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/r0bertson/goswag/internal/docs"
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/internal/validator"
+	"github.com/r0bertson/goswag/models"
+)
+
+type chiSwagger struct {
+	*chiRouter
+	defaultResponses []models.ReturnType
+	info             *models.Info
+}
+
+func NewChi(r chi.Router, defaultResponses ...models.ReturnType) *chiSwagger {
+	return &chiSwagger{
+		chiRouter:        &chiRouter{r: r},
+		defaultResponses: defaultResponses,
+	}
+}
+
+func (s *chiSwagger) Router() chi.Router {
+	return s.r
+}
+
+// ServeHTTP serves the requests with the chi router, so the wrapper can be mounted or served directly.
+func (s *chiSwagger) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.r.ServeHTTP(w, r)
+}
+
+// SetInfo sets the general information of the API written in the generated documentation.
+func (s *chiSwagger) SetInfo(info models.Info) {
+	s.info = &info
+}
+
+func (s *chiSwagger) GenerateSwagger() {
+	routes, groups := s.toGoSwag("")
+	generator.GenerateSwagger(routes, groups, s.defaultResponses, s.info)
+}
+
+func (s *chiSwagger) GenerateSwaggerE(opts models.GenerateOptions) error {
+	if opts.Info == nil {
+		opts.Info = s.info
+	}
+
+	routes, groups := s.toGoSwag("")
+	return generator.Generate(routes, groups, s.defaultResponses, opts)
+}
+
+func (s *chiSwagger) GenerateOpenAPI() {
+	routes, groups := s.toGoSwag("")
+	generator.GenerateOpenAPI(routes, groups, s.defaultResponses, s.info)
+}
+
+// ServeDocs mounts the Swagger UI, Redoc and the OpenAPI document of the routes under the prefix.
+// The docs routes are registered directly in the router, so they are not documented.
+func (s *chiSwagger) ServeDocs(prefix string) {
+	h := docs.Handler(prefix, s.OpenAPI)
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		// a catch-all at the root would answer every unmatched GET
+		for _, path := range docs.Paths {
+			s.r.Get(path, h.ServeHTTP)
+		}
+
+		return
+	}
+
+	s.r.Get(prefix, h.ServeHTTP)
+	s.r.Get(prefix+"/*", h.ServeHTTP)
+}
+
+// ValidationMiddleware returns a middleware that rejects the requests that do not match the
+// documentation of their route. The route is found with the chi router, so it can be
+// registered with Use or wrap the router.
+func (s *chiSwagger) ValidationMiddleware(opts ...models.ValidationOptions) func(http.Handler) http.Handler {
+	registry := validator.NewRegistry(func() ([]generator.Route, []generator.Group) {
+		return s.toGoSwag("")
+	}, opts...)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rctx := chi.NewRouteContext()
+			pattern := s.r.Find(rctx, r.Method, r.URL.Path)
+			if pattern == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// the routes are documented without the regular expressions of the pattern
+			path, _ := toSwagPath(pattern)
+			if registry.Check(w, r, path, rctx.URLParam) {
+				next.ServeHTTP(w, r)
+			}
+		})
+	}
+}
+
+// OpenAPI returns the json of the OpenAPI 3.1 document of the routes registered so far.
+func (s *chiSwagger) OpenAPI() ([]byte, error) {
+	routes, groups := s.toGoSwag("")
+	return generator.MarshalOpenAPI(routes, groups, s.defaultResponses, s.info)
+}
+
+type chiMount struct {
+	pattern string
+	prefix  string
+	router  *chiRouter
+}
+
+type chiRouter struct {
+	r         chi.Router
+	prefix    string
+	groupName string
+	groups    []*chiRouter
+	mounts    []*chiMount
+	routes    []*chiRoute
+}
+
+func (c *chiRouter) Use(middlewares ...func(http.Handler) http.Handler) {
+	c.r.Use(middlewares...)
+}
+
+// With returns an inline router whose routes are wrapped by the middlewares.
+// The routes are documented with the tags of the router, the inline router has no tag of its own.
+func (c *chiRouter) With(middlewares ...func(http.Handler) http.Handler) models.ChiRouter {
+	g := &chiRouter{r: c.r.With(middlewares...), prefix: c.prefix}
+	c.groups = append(c.groups, g)
+
+	return g
+}
+
+// Group returns an inline router with a copy of the middleware stack.
+// The routes are documented with the tags of the router, the inline router has no tag of its own.
+func (c *chiRouter) Group(fn func(r models.ChiRouter)) models.ChiRouter {
+	g := &chiRouter{prefix: c.prefix}
+	c.groups = append(c.groups, g)
+
+	g.r = c.r.Group(func(r chi.Router) {
+		g.r = r
+		if fn != nil {
+			fn(g)
+		}
+	})
+
+	return g
+}
+
+func (c *chiRouter) Route(pattern string, fn func(r models.ChiRouter)) models.ChiRouter {
+	g := &chiRouter{prefix: getFullPath(c.prefix, pattern), groupName: pattern}
+	c.groups = append(c.groups, g)
+
+	g.r = c.r.Route(pattern, func(r chi.Router) {
+		g.r = r
+		if fn != nil {
+			fn(g)
+		}
+	})
+
+	return g
+}
+
+func (c *chiRouter) Mount(pattern string, h http.Handler) {
+	sub, ok := h.(*chiSwagger)
+	if !ok {
+		c.r.Mount(pattern, h)
+		return
+	}
+
+	// the chi router is mounted, so chi can find the routes of the sub-router
+	c.r.Mount(pattern, sub.r)
+	c.mounts = append(c.mounts, &chiMount{pattern: pattern, prefix: getFullPath(c.prefix, pattern), router: sub.chiRouter})
+}
+
+func (c *chiRouter) Method(method, pattern string, h http.Handler) models.Swagger {
+	c.r.Method(method, pattern, h)
+
+	tpl, params := toSwagPath(getFullPath(c.prefix, pattern))
+	cr := &chiRoute{
+		Route: generator.Route{
+			Path:       tpl,
+			Method:     strings.ToUpper(method),
+			FuncName:   getFuncName(h),
+			PathParams: params,
+		},
+	}
+
+	c.routes = append(c.routes, cr)
+
+	return cr
+}
+
+// Any registers the handler for every method, the methods of generator.AnyMethods are documented.
+func (c *chiRouter) Any(pattern string, h http.HandlerFunc) models.MultiSwagger {
+	c.r.HandleFunc(pattern, h)
+	return c.document(generator.AnyMethods, pattern, h)
+}
+
+func (c *chiRouter) Match(methods []string, pattern string, h http.HandlerFunc) models.MultiSwagger {
+	for _, method := range methods {
+		c.r.MethodFunc(method, pattern, h)
+	}
+
+	return c.document(methods, pattern, h)
+}
+
+// document adds one route per method, the handler is registered by the caller.
+func (c *chiRouter) document(methods []string, pattern string, h http.Handler) chiOperations {
+	operations := make(chiOperations, 0, len(methods))
+	for _, method := range methods {
+		tpl, params := toSwagPath(getFullPath(c.prefix, pattern))
+		cr := &chiRoute{
+			Route: generator.Route{
+				Path:       tpl,
+				Method:     strings.ToUpper(method),
+				FuncName:   getFuncName(h),
+				PathParams: params,
+			},
+		}
+
+		c.routes = append(c.routes, cr)
+		operations = append(operations, cr)
+	}
+
+	return operations
+}
+
+func (c *chiRouter) MethodFunc(method, pattern string, h http.HandlerFunc) models.Swagger {
+	return c.Method(method, pattern, h)
+}
+
+func (c *chiRouter) Get(pattern string, h http.HandlerFunc) models.Swagger {
+	return c.Method(http.MethodGet, pattern, h)
+}
+
+func (c *chiRouter) Post(pattern string, h http.HandlerFunc) models.Swagger {
+	return c.Method(http.MethodPost, pattern, h)
+}
+
+func (c *chiRouter) Put(pattern string, h http.HandlerFunc) models.Swagger {
+	return c.Method(http.MethodPut, pattern, h)
+}
+
+func (c *chiRouter) Delete(pattern string, h http.HandlerFunc) models.Swagger {
+	return c.Method(http.MethodDelete, pattern, h)
+}
+
+func (c *chiRouter) Patch(pattern string, h http.HandlerFunc) models.Swagger {
+	return c.Method(http.MethodPatch, pattern, h)
+}
+
+func (c *chiRouter) Options(pattern string, h http.HandlerFunc) models.Swagger {
+	return c.Method(http.MethodOptions, pattern, h)
+}
+
+func (c *chiRouter) Head(pattern string, h http.HandlerFunc) models.Swagger {
+	return c.Method(http.MethodHead, pattern, h)
+}
+
+type chiRoute struct {
+	Route generator.Route
+}
+
+func (r *chiRoute) Summary(summary string) models.Swagger {
+	r.Route.Summary = summary
+	return r
+}
+
+func (r *chiRoute) Description(description string) models.Swagger {
+	r.Route.Description = description
+	return r
+}
+
+func (r *chiRoute) Tags(tags ...string) models.Swagger {
+	r.Route.Tags = tags
+	return r
+}
+
+func (r *chiRoute) Accepts(accepts ...string) models.Swagger {
+	r.Route.Accepts = accepts
+	return r
+}
+
+func (r *chiRoute) Produces(produces ...string) models.Swagger {
+	r.Route.Produces = produces
+	return r
+}
+
+func (r *chiRoute) Read(reads interface{}) models.Swagger {
+	r.Route.Reads = reads
+	return r
+}
+
+func (r *chiRoute) ReadFieldDescriptions(descriptions map[string]string) models.Swagger {
+	r.Route.ReadFieldDescriptions = descriptions
+	return r
+}
+
+func (r *chiRoute) Returns(returns []models.ReturnType) models.Swagger {
+	r.Route.Returns = returns
+	return r
+}
+
+func (r *chiRoute) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.QueryParams = append(r.Route.QueryParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *chiRoute) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.HeaderParams = append(r.Route.HeaderParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+// PathParam refines the parameter declared from the path variable with the same name.
+func (r *chiRoute) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.PathParams = generator.SetParam(r.Route.PathParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *chiRoute) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.CookieParams = append(r.Route.CookieParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *chiRoute) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *chiRoute) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, "file", required, opts...))
+	return r
+}
+
+// Params declares the query, header and path parameters read from the tags of the fields of a struct.
+func (r *chiRoute) Params(v any) models.Swagger {
+	path, query, header := generator.StructParams(v, r.Route.Method)
+	r.Route.PathParams = generator.SetParam(r.Route.PathParams, path...)
+	r.Route.QueryParams = generator.SetParam(r.Route.QueryParams, query...)
+	r.Route.HeaderParams = generator.SetParam(r.Route.HeaderParams, header...)
+
+	return r
+}
+
+func (r *chiRoute) Security(schemes ...string) models.Swagger {
+	r.Route.Security = append(r.Route.Security, schemes...)
+	return r
+}
+
+// chiOperations applies the annotations to the routes of all the methods of a pattern.
+type chiOperations []*chiRoute
+
+func (ops chiOperations) each(fn func(r *chiRoute)) models.Swagger {
+	for _, r := range ops {
+		fn(r)
+	}
+
+	return ops
+}
+
+// Method returns the route of the method, or a detached one that is not documented.
+func (ops chiOperations) Method(method string) models.Swagger {
+	for _, r := range ops {
+		if strings.EqualFold(r.Route.Method, method) {
+			return r
+		}
+	}
+
+	return &chiRoute{}
+}
+
+func (ops chiOperations) Summary(summary string) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.Summary(summary) })
+}
+
+func (ops chiOperations) Description(description string) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.Description(description) })
+}
+
+func (ops chiOperations) Tags(tags ...string) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.Tags(tags...) })
+}
+
+func (ops chiOperations) Accepts(accepts ...string) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.Accepts(accepts...) })
+}
+
+func (ops chiOperations) Produces(produces ...string) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.Produces(produces...) })
+}
+
+func (ops chiOperations) Read(reads interface{}) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.Read(reads) })
+}
+
+func (ops chiOperations) ReadFieldDescriptions(descriptions map[string]string) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.ReadFieldDescriptions(descriptions) })
+}
+
+func (ops chiOperations) Returns(returns []models.ReturnType) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.Returns(returns) })
+}
+
+func (ops chiOperations) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.QueryParam(name, description, paramType, required, opts...) })
+}
+
+func (ops chiOperations) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.HeaderParam(name, description, paramType, required, opts...) })
+}
+
+func (ops chiOperations) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.PathParam(name, description, paramType, required, opts...) })
+}
+
+func (ops chiOperations) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.CookieParam(name, description, paramType, required, opts...) })
+}
+
+func (ops chiOperations) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.FormParam(name, description, paramType, required, opts...) })
+}
+
+func (ops chiOperations) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.FileParam(name, description, required, opts...) })
+}
+
+func (ops chiOperations) Params(v any) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.Params(v) })
+}
+
+func (ops chiOperations) Security(schemes ...string) models.Swagger {
+	return ops.each(func(r *chiRoute) { r.Security(schemes...) })
+}
@@ -0,0 +1,291 @@
+package chi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func handleGetUser(w http.ResponseWriter, r *http.Request) {
+	_, _ = w.Write([]byte(chi.URLParam(r, "id")))
+}
+
+type statusHandler struct{}
+
+func (statusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {}
+
+func TestNewChi(t *testing.T) {
+	r := chi.NewRouter()
+	s := NewChi(r)
+
+	assert.Equal(t, r, s.Router())
+}
+
+func TestChiSwagger_routes(t *testing.T) {
+	s := NewChi(chi.NewRouter())
+	noop := func(r models.ChiRouter) {}
+
+	s.Get("/health", handleGetUser)
+	s.Route("/v1", func(r models.ChiRouter) {
+		r.Route("/users", func(r models.ChiRouter) {
+			r.Get("/{id}", handleGetUser).Summary("Get user")
+			r.With(func(next http.Handler) http.Handler { return next }).Delete("/{id}", handleGetUser)
+			r.Group(func(r models.ChiRouter) {
+				r.Post("/", handleGetUser)
+			})
+		})
+		r.Method("patch", "/status", statusHandler{})
+	})
+	s.Route("/empty", noop)
+
+	admin := NewChi(chi.NewRouter())
+	admin.Put("/settings", handleGetUser)
+	s.Mount("/admin", admin)
+	s.Mount("/static", http.NotFoundHandler())
+
+	routes, groups := s.toGoSwag("")
+
+	assert.Equal(t, []generator.Route{{Path: "/health", Method: http.MethodGet, FuncName: "handleGetUser"}}, routes)
+	assert.Equal(t, []generator.Group{
+		{
+			GroupName: "/v1",
+			Routes:    []generator.Route{{Path: "/v1/status", Method: http.MethodPatch, FuncName: "statusHandler"}},
+			Groups: []generator.Group{
+				{
+					GroupName: "/users",
+					Routes:    []generator.Route{{Path: "/v1/users/{id}", Method: http.MethodGet, FuncName: "handleGetUser", Summary: "Get user", PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}}}},
+					Groups: []generator.Group{
+						{Routes: []generator.Route{{Path: "/v1/users/{id}", Method: http.MethodDelete, FuncName: "handleGetUser", PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}}}}},
+						{Routes: []generator.Route{{Path: "/v1/users/", Method: http.MethodPost, FuncName: "handleGetUser"}}},
+					},
+				},
+			},
+		},
+		{GroupName: "/empty"},
+		{GroupName: "/admin", Routes: []generator.Route{{Path: "/admin/settings", Method: http.MethodPut, FuncName: "handleGetUser"}}},
+	}, groups)
+
+	for path, want := range map[string]string{"/v1/users/42": "42", "/admin/settings": ""} {
+		method := http.MethodGet
+		if path == "/admin/settings" {
+			method = http.MethodPut
+		}
+
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code, path)
+		assert.Equal(t, want, w.Body.String(), path)
+	}
+}
+
+func TestToSwagPath(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		want       string
+		wantParams []generator.Param
+	}{
+		{pattern: "/users", want: "/users"},
+		{pattern: "/files/*", want: "/files/*"},
+		{
+			pattern: "/users/{id}/posts/{slug:[a-z]{2,}}",
+			want:    "/users/{id}/posts/{slug}",
+			wantParams: []generator.Param{
+				{Name: "id", ParamType: "string", Required: true},
+				{Name: "slug", ParamType: "string", Required: true, Pattern: "^[a-z]{2,}$"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			path, params := toSwagPath(tt.pattern)
+			assert.Equal(t, tt.want, path)
+			assert.Equal(t, tt.wantParams, params)
+		})
+	}
+}
+
+func TestChiSwagger_nestedTags(t *testing.T) {
+	s := NewChi(chi.NewRouter())
+	s.Route("/users", func(r models.ChiRouter) {
+		r.Group(func(r models.ChiRouter) {
+			r.Get("/{id}", handleGetUser)
+		})
+		r.With(func(next http.Handler) http.Handler { return next }).Delete("/{id}", handleGetUser)
+		r.Route("/{id}/posts", func(r models.ChiRouter) {
+			r.Group(func(r models.ChiRouter) {
+				r.Get("/", handleGetUser)
+			})
+		})
+	})
+
+	routes, groups := s.toGoSwag("")
+	doc := generator.BuildOpenAPI(routes, groups, nil, nil)
+	assert.Equal(t, []string{"/users"}, doc.Paths["/users/{id}"]["get"].Tags)
+	assert.Equal(t, []string{"/users"}, doc.Paths["/users/{id}"]["delete"].Tags)
+	assert.Equal(t, []string{"/{id}/posts"}, doc.Paths["/users/{id}/posts/"]["get"].Tags)
+}
+
+func TestChiSwagger_GenerateSwaggerE_closures(t *testing.T) {
+	s := NewChi(chi.NewRouter())
+	s.Route("/users", func(r models.ChiRouter) {
+		// the runtime names the nested closures after their position, e.g. "1"
+		r.Get("/{id}", func(w http.ResponseWriter, r *http.Request) {})
+	})
+
+	assert.Equal(t, "1", s.groups[0].routes[0].Route.FuncName)
+
+	var b strings.Builder
+	assert.NoError(t, s.GenerateSwaggerE(models.GenerateOptions{Writer: &b}))
+	assert.Contains(t, b.String(), "func getUsersId() {}")
+}
+
+func TestChiSwagger_OpenAPI(t *testing.T) {
+	s := NewChi(chi.NewRouter())
+	s.Route("/users", func(r models.ChiRouter) {
+		r.Get("/{id}", handleGetUser).PathParam("id", "user id", "int", true)
+	})
+
+	content, err := s.OpenAPI()
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), `"/users/{id}"`)
+	assert.Contains(t, string(content), `"/users"`)
+}
+
+func TestChiSwagger_ServeDocs(t *testing.T) {
+	s := NewChi(chi.NewRouter())
+	s.ServeDocs("/docs")
+	s.Get("/users/{id}", handleGetUser).Summary("Get user")
+
+	for path, contains := range map[string]string{
+		"/docs":              "swagger-ui",
+		"/docs/redoc":        "redoc",
+		"/docs/openapi.json": `"/users/{id}"`,
+		"/docs/openapi.yaml": "summary: Get user",
+	} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code, path)
+		assert.Contains(t, w.Body.String(), contains, path)
+	}
+}
+
+func TestChiSwagger_ServeDocsRoot(t *testing.T) {
+	s := NewChi(chi.NewRouter())
+	s.ServeDocs("/")
+	s.Get("/users/{id}", handleGetUser)
+
+	// the other routes are still reachable next to the docs served at the root
+	for path, contains := range map[string]string{
+		"/":             "swagger-ui",
+		"/redoc":        "redoc",
+		"/openapi.json": `"/users/{id}"`,
+		"/users/1":      "",
+	} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code, path)
+		assert.Contains(t, w.Body.String(), contains, path)
+	}
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/unknown", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestChiSwagger_ValidationMiddleware(t *testing.T) {
+	s := NewChi(chi.NewRouter())
+	s.Use(s.ValidationMiddleware())
+	s.Route("/users", func(r models.ChiRouter) {
+		r.Get("/{id}", handleGetUser).
+			PathParam("id", "user id", "int", true).
+			QueryParam("verbose", "verbose output", "bool", false)
+	})
+
+	for path, want := range map[string]int{
+		"/users/1":             http.StatusOK,
+		"/users/a":             http.StatusBadRequest,
+		"/users/1?verbose=yes": http.StatusBadRequest,
+		"/items/1":             http.StatusNotFound,
+	} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, want, w.Code, path)
+	}
+}
+
+func TestChiRoute(t *testing.T) {
+	r := &chiRoute{}
+	r.Summary("summary").
+		Description("description").
+		Tags("users").
+		Accepts("json").
+		Produces("xml").
+		Read(struct{}{}).
+		ReadFieldDescriptions(map[string]string{"name": "Name"}).
+		Returns([]models.ReturnType{{StatusCode: http.StatusOK}}).
+		QueryParam("q", "query", "string", false).
+		HeaderParam("h", "header", "string", true).
+		PathParam("id", "id", "int", true).
+		Security("BearerAuth")
+
+	assert.Equal(t, generator.Route{
+		Summary:               "summary",
+		Description:           "description",
+		Tags:                  []string{"users"},
+		Accepts:               []string{"json"},
+		Produces:              []string{"xml"},
+		Reads:                 struct{}{},
+		ReadFieldDescriptions: map[string]string{"name": "Name"},
+		Returns:               []models.ReturnType{{StatusCode: http.StatusOK}},
+		QueryParams:           []generator.Param{{Name: "q", Description: "query", ParamType: "string"}},
+		HeaderParams:          []generator.Param{{Name: "h", Description: "header", ParamType: "string", Required: true}},
+		PathParams:            []generator.Param{{Name: "id", Description: "id", ParamType: "int", Required: true}},
+		Security:              []string{"BearerAuth"},
+	}, r.Route)
+}
+
+func TestChiSwagger_AnyMatch(t *testing.T) {
+	s := NewChi(chi.NewRouter())
+
+	s.Route("/files", func(r models.ChiRouter) {
+		m := r.Match([]string{"get", http.MethodHead}, "/{id}", handleGetUser)
+		m.Summary("Get file").Tags("files")
+		m.Method(http.MethodHead).Summary("Check file")
+		m.Method(http.MethodPost).Summary("not documented")
+	})
+	s.Any("/echo", handleGetUser).Summary("Echo")
+
+	routes, groups := s.toGoSwag("")
+
+	assert.Len(t, routes, len(generator.AnyMethods))
+	for i, r := range routes {
+		assert.Equal(t, generator.Route{Path: "/echo", Method: generator.AnyMethods[i], FuncName: "handleGetUser", Summary: "Echo"}, r)
+	}
+
+	idParam := generator.Param{Name: "id", ParamType: "string", Required: true}
+	assert.Equal(t, []generator.Route{
+		{Path: "/files/{id}", Method: http.MethodGet, FuncName: "handleGetUser", Summary: "Get file", Tags: []string{"files"}, PathParams: []generator.Param{idParam}},
+		{Path: "/files/{id}", Method: http.MethodHead, FuncName: "handleGetUser", Summary: "Check file", Tags: []string{"files"}, PathParams: []generator.Param{idParam}},
+	}, groups[0].Routes)
+
+	for method, want := range map[string]int{http.MethodGet: http.StatusOK, http.MethodPost: http.StatusMethodNotAllowed} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(method, "/files/42", nil))
+		assert.Equal(t, want, w.Code, method)
+	}
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest("TRACE", "/echo", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,142 @@
+package chi
+
+import (
+	"net/http"
+	"path"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/r0bertson/goswag/internal/generator"
+)
+
+// getFuncName retrieves the name of the function of the handler.
+// It uses the reflect package to obtain the function name from the pointer value of the handler.
+// The function name is extracted by splitting the full function name string using the dot separator and returning the last element.
+// Handlers that are not functions are named after their type.
+func getFuncName(h http.Handler) string {
+	var fullFuncName string
+	if hf, ok := h.(http.HandlerFunc); ok {
+		fullFuncName = runtime.FuncForPC(reflect.ValueOf(hf).Pointer()).Name()
+	} else {
+		fullFuncName = reflect.Indirect(reflect.ValueOf(h)).Type().Name()
+	}
+
+	funcNameSplit := strings.Split(fullFuncName, ".")
+	funcName := funcNameSplit[len(funcNameSplit)-1]
+	funcName = strings.TrimSuffix(funcName, "-fm")
+
+	return funcName
+}
+
+// toSwagPath translates a chi pattern to a path template without the regular expressions
+// of the parameters, e.g. /users/{id:[0-9]+} becomes /users/{id}. Every parameter is returned as a
+// required string path parameter, the ones with a regular expression have it as pattern.
+// The catch-all * is kept, it has no name to be documented with.
+func toSwagPath(pattern string) (string, []generator.Param) {
+	var (
+		path   strings.Builder
+		params []generator.Param
+		depth  int
+		start  int
+	)
+
+	// the braces are counted, so the regular expressions may have quantifiers like {2}
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '{':
+			if depth == 0 {
+				start = i + 1
+			}
+			depth++
+			continue
+		case '}':
+			depth--
+			if depth == 0 {
+				name, rx, _ := strings.Cut(pattern[start:i], ":")
+				params = generator.SetParam(params, generator.Param{Name: name, ParamType: "string", Required: true, Pattern: anchor(rx)})
+				path.WriteString("{" + name + "}")
+			}
+			continue
+		}
+
+		if depth == 0 {
+			path.WriteByte(pattern[i])
+		}
+	}
+
+	return path.String(), params
+}
+
+// anchor anchors the regular expression as chi does before matching it against the value.
+func anchor(rx string) string {
+	if rx == "" {
+		return ""
+	}
+
+	if !strings.HasPrefix(rx, "^") {
+		rx = "^" + rx
+	}
+
+	if !strings.HasSuffix(rx, "$") {
+		rx += "$"
+	}
+
+	return rx
+}
+
+// toGoSwag converts the routes and sub-routers of the router to generator.Route and generator.Group.
+// The paths are prefixed by mountPrefix, the pattern where the router is mounted.
+// The routers mounted with Mount become groups named after their pattern.
+func (c *chiRouter) toGoSwag(mountPrefix string) ([]generator.Route, []generator.Group) {
+	var routes []generator.Route
+	for _, r := range c.routes {
+		route := r.Route
+		if mountPrefix != "" {
+			// the parameters of the mount pattern are declared before the ones of the route
+			path, params := toSwagPath(getFullPath(mountPrefix, route.Path))
+			for _, p := range route.PathParams {
+				params = generator.SetParam(params, p)
+			}
+
+			route.Path, route.PathParams = path, params
+		}
+
+		routes = append(routes, route)
+	}
+
+	var groups []generator.Group
+	for _, g := range c.groups {
+		groupRoutes, subGroups := g.toGoSwag(mountPrefix)
+		groups = append(groups, generator.Group{
+			GroupName: g.groupName,
+			Routes:    groupRoutes,
+			Groups:    subGroups,
+		})
+	}
+
+	for _, m := range c.mounts {
+		groupRoutes, subGroups := m.router.toGoSwag(getFullPath(mountPrefix, m.prefix))
+		groups = append(groups, generator.Group{
+			GroupName: m.pattern,
+			Routes:    groupRoutes,
+			Groups:    subGroups,
+		})
+	}
+
+	return routes, groups
+}
+
+func getFullPath(groupName, relativePath string) string {
+	if groupName == "" {
+		return relativePath
+	}
+
+	fullPath := path.Join(groupName, relativePath)
+
+	if strings.HasSuffix(relativePath, "/") {
+		fullPath += "/"
+	}
+
+	return fullPath
+}
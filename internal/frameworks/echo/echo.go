@@ -1,21 +1,44 @@
 package echo
 
 import (
+	"net/http"
+	"strings"
+
 	"github.com/labstack/echo/v4"
+	"github.com/r0bertson/goswag/internal/docs"
 	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/internal/validator"
 	"github.com/r0bertson/goswag/models"
 )
 
 type echoSwagger struct {
-	e                *echo.Echo
+	e *echo.Echo
+	// g is the group wrapped by NewEchoGroup, the routes are registered in it instead of e
+	g                *echo.Group
 	groups           []*echoGroup
 	routes           []*echoRoute
 	defaultResponses []models.ReturnType
+	info             *models.Info
 }
 
 func NewEcho(defaultResponses ...models.ReturnType) *echoSwagger {
+	return NewEchoFrom(echo.New(), defaultResponses...)
+}
+
+// NewEchoFrom wraps an existing echo instance, its configuration (binder, validator, error handler...) is kept.
+func NewEchoFrom(e *echo.Echo, defaultResponses ...models.ReturnType) *echoSwagger {
+	return &echoSwagger{
+		e:                e,
+		defaultResponses: defaultResponses,
+	}
+}
+
+// NewEchoGroup wraps an existing group of e, the routes registered through it are documented with their full path.
+// e serves the routes, echo does not expose the instance that owns a group.
+func NewEchoGroup(e *echo.Echo, g *echo.Group, defaultResponses ...models.ReturnType) *echoSwagger {
 	return &echoSwagger{
-		e:                echo.New(),
+		e:                e,
+		g:                g,
 		defaultResponses: defaultResponses,
 	}
 }
@@ -24,25 +47,138 @@ func (s *echoSwagger) Echo() *echo.Echo {
 	return s.e
 }
 
+// Handler returns the echo instance serving the routes, e.g. for httptest.
+func (s *echoSwagger) Handler() http.Handler {
+	return s.e
+}
+
+func (s *echoSwagger) EchoGroup() *echo.Group {
+	return s.g
+}
+
+// echoRouter is implemented by *echo.Echo and *echo.Group.
+type echoRouter interface {
+	Group(prefix string, m ...echo.MiddlewareFunc) *echo.Group
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	Any(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) []*echo.Route
+	Match(methods []string, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) []*echo.Route
+}
+
+// router returns where the routes are registered, the wrapped group or the echo instance.
+func (s *echoSwagger) router() echoRouter {
+	if s.g != nil {
+		return s.g
+	}
+
+	return s.e
+}
+
+// SetInfo sets the general information of the API written in the generated documentation.
+func (s *echoSwagger) SetInfo(info models.Info) {
+	s.info = &info
+}
+
 func (s *echoSwagger) GenerateSwagger() {
-	generator.GenerateSwagger(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses)
+	generator.GenerateSwagger(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
+}
+
+func (s *echoSwagger) GenerateSwaggerE(opts models.GenerateOptions) error {
+	if opts.Info == nil {
+		opts.Info = s.info
+	}
+
+	return generator.Generate(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, opts)
+}
+
+func (s *echoSwagger) GenerateOpenAPI() {
+	generator.GenerateOpenAPI(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
+}
+
+// ServeDocs mounts the Swagger UI, Redoc and the OpenAPI document of the routes under the prefix.
+// The docs routes are registered directly in the router, so they are not documented.
+// When a group is wrapped, the prefix is relative to the group.
+func (s *echoSwagger) ServeDocs(prefix string) {
+	var h echo.HandlerFunc
+	serve := func(c echo.Context) error { return h(c) }
+
+	prefix = strings.TrimSuffix(prefix, "/")
+	paths := []string{prefix, prefix + "/*"}
+	if prefix == "" {
+		// a catch-all at the root would answer every unmatched GET
+		paths = docs.Paths
+	}
+
+	r := s.router().GET(paths[0], serve)
+	for _, path := range paths[1:] {
+		s.router().GET(path, serve)
+	}
+
+	// the full path of the docs is only known once they are registered
+	h = echo.WrapHandler(docs.Handler(r.Path, s.OpenAPI))
+}
+
+// ValidationMiddleware returns a middleware that rejects the requests that do not match the
+// documentation of their route. It must be registered with Echo().Use, or EchoGroup().Use for
+// a wrapped group, so the route is known.
+func (s *echoSwagger) ValidationMiddleware(opts ...models.ValidationOptions) echo.MiddlewareFunc {
+	registry := validator.NewRegistry(func() ([]generator.Route, []generator.Group) {
+		return toGoSwagRoute(s.routes), toGoSwagGroup(s.groups)
+	}, opts...)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !registry.Check(c.Response(), c.Request(), c.Path(), c.Param) {
+				return nil
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// OpenAPI returns the json of the OpenAPI 3.1 document of the routes registered so far.
+func (s *echoSwagger) OpenAPI() ([]byte, error) {
+	return generator.MarshalOpenAPI(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
 }
 
 func (s *echoSwagger) Group(prefix string, m ...echo.MiddlewareFunc) models.EchoGroup {
-	g := &echoGroup{g: s.e.Group(prefix, m...), groupName: prefix}
+	g := &echoGroup{g: s.router().Group(prefix, m...), groupName: prefix}
 	s.groups = append(s.groups, g)
 
 	return g
 }
 
+// Any registers the route for every method, the methods of generator.AnyMethods are documented.
+func (s *echoSwagger) Any(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.MultiSwagger {
+	operations := toEchoOperations(s.router().Any(path, h, m...), generator.AnyMethods)
+	s.routes = append(s.routes, operations...)
+
+	return operations
+}
+
+func (s *echoSwagger) Match(methods []string, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.MultiSwagger {
+	operations := toEchoOperations(s.router().Match(methods, path, h, m...), methods)
+	s.routes = append(s.routes, operations...)
+
+	return operations
+}
+
 func (s *echoSwagger) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
-	r := s.e.POST(path, h, m...)
+	r := s.router().POST(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -52,13 +188,15 @@ func (s *echoSwagger) POST(path string, h echo.HandlerFunc, m ...echo.Middleware
 }
 
 func (s *echoSwagger) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
-	r := s.e.GET(path, h, m...)
+	r := s.router().GET(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -68,13 +206,15 @@ func (s *echoSwagger) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareF
 }
 
 func (s *echoSwagger) PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
-	r := s.e.PUT(path, h, m...)
+	r := s.router().PUT(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -84,13 +224,15 @@ func (s *echoSwagger) PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareF
 }
 
 func (s *echoSwagger) DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
-	r := s.e.DELETE(path, h, m...)
+	r := s.router().DELETE(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -100,13 +242,15 @@ func (s *echoSwagger) DELETE(path string, h echo.HandlerFunc, m ...echo.Middlewa
 }
 
 func (s *echoSwagger) PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
-	r := s.e.PATCH(path, h, m...)
+	r := s.router().PATCH(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -116,13 +260,15 @@ func (s *echoSwagger) PATCH(path string, h echo.HandlerFunc, m ...echo.Middlewar
 }
 
 func (s *echoSwagger) OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
-	r := s.e.OPTIONS(path, h, m...)
+	r := s.router().OPTIONS(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -132,13 +278,15 @@ func (s *echoSwagger) OPTIONS(path string, h echo.HandlerFunc, m ...echo.Middlew
 }
 
 func (s *echoSwagger) HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
-	r := s.e.HEAD(path, h, m...)
+	r := s.router().HEAD(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -162,14 +310,31 @@ func (s *echoGroup) Group(prefix string, m ...echo.MiddlewareFunc) models.EchoGr
 	return g
 }
 
+// Any registers the route for every method, the methods of generator.AnyMethods are documented.
+func (s *echoGroup) Any(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.MultiSwagger {
+	operations := toEchoOperations(s.g.Any(path, h, m...), generator.AnyMethods)
+	s.routes = append(s.routes, operations...)
+
+	return operations
+}
+
+func (s *echoGroup) Match(methods []string, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.MultiSwagger {
+	operations := toEchoOperations(s.g.Match(methods, path, h, m...), methods)
+	s.routes = append(s.routes, operations...)
+
+	return operations
+}
+
 func (s *echoGroup) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
 	r := s.g.POST(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -181,11 +346,13 @@ func (s *echoGroup) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFu
 func (s *echoGroup) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
 	r := s.g.GET(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -197,11 +364,13 @@ func (s *echoGroup) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFun
 func (s *echoGroup) PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
 	r := s.g.PUT(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -213,11 +382,13 @@ func (s *echoGroup) PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFun
 func (s *echoGroup) DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
 	r := s.g.DELETE(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -229,11 +400,13 @@ func (s *echoGroup) DELETE(path string, h echo.HandlerFunc, m ...echo.Middleware
 func (s *echoGroup) PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
 	r := s.g.PATCH(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -245,11 +418,13 @@ func (s *echoGroup) PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareF
 func (s *echoGroup) OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
 	r := s.g.OPTIONS(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -261,11 +436,13 @@ func (s *echoGroup) OPTIONS(path string, h echo.HandlerFunc, m ...echo.Middlewar
 func (s *echoGroup) HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) models.Swagger {
 	r := s.g.HEAD(path, h, m...)
 
+	tpl, params := generator.PathTemplate(r.Path)
 	er := &echoRoute{
 		Route: generator.Route{
-			Path:     r.Path,
-			Method:   r.Method,
-			FuncName: getFuncName(r.Name),
+			Path:       tpl,
+			Method:     r.Method,
+			FuncName:   getFuncName(r.Name),
+			PathParams: params,
 		},
 	}
 
@@ -318,35 +495,46 @@ func (r *echoRoute) Returns(returns []models.ReturnType) models.Swagger {
 	return r
 }
 
-func (r *echoRoute) QueryParam(name, description, paramType string, required bool) models.Swagger {
-	r.Route.QueryParams = append(r.Route.QueryParams, generator.Param{
-		Name:        name,
-		Description: description,
-		ParamType:   paramType,
-		Required:    required,
-	})
+func (r *echoRoute) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.QueryParams = append(r.Route.QueryParams, generator.NewParam(name, description, paramType, required, opts...))
 
 	return r
 }
 
-func (r *echoRoute) HeaderParam(name, description, paramType string, required bool) models.Swagger {
-	r.Route.HeaderParams = append(r.Route.HeaderParams, generator.Param{
-		Name:        name,
-		Description: description,
-		ParamType:   paramType,
-		Required:    required,
-	})
+func (r *echoRoute) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.HeaderParams = append(r.Route.HeaderParams, generator.NewParam(name, description, paramType, required, opts...))
 
 	return r
 }
 
-func (r *echoRoute) PathParam(name, description, paramType string, required bool) models.Swagger {
-	r.Route.PathParams = append(r.Route.PathParams, generator.Param{
-		Name:        name,
-		Description: description,
-		ParamType:   paramType,
-		Required:    required,
-	})
+// PathParam refines the parameter declared from the path variable with the same name.
+func (r *echoRoute) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.PathParams = generator.SetParam(r.Route.PathParams, generator.NewParam(name, description, paramType, required, opts...))
+
+	return r
+}
+
+func (r *echoRoute) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.CookieParams = append(r.Route.CookieParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *echoRoute) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *echoRoute) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, "file", required, opts...))
+	return r
+}
+
+// Params declares the query, header and path parameters read from the tags of the fields of a struct.
+func (r *echoRoute) Params(v any) models.Swagger {
+	path, query, header := generator.StructParams(v, r.Route.Method)
+	r.Route.PathParams = generator.SetParam(r.Route.PathParams, path...)
+	r.Route.QueryParams = generator.SetParam(r.Route.QueryParams, query...)
+	r.Route.HeaderParams = generator.SetParam(r.Route.HeaderParams, header...)
 
 	return r
 }
@@ -355,3 +543,89 @@ func (r *echoRoute) Security(schemes ...string) models.Swagger {
 	r.Route.Security = append(r.Route.Security, schemes...)
 	return r
 }
+
+// echoOperations applies the annotations to the routes of all the methods of a path.
+type echoOperations []*echoRoute
+
+func (ops echoOperations) each(fn func(r *echoRoute)) models.Swagger {
+	for _, r := range ops {
+		fn(r)
+	}
+
+	return ops
+}
+
+// Method returns the route of the method, or a detached one that is not documented.
+func (ops echoOperations) Method(method string) models.Swagger {
+	for _, r := range ops {
+		if strings.EqualFold(r.Route.Method, method) {
+			return r
+		}
+	}
+
+	return &echoRoute{}
+}
+
+func (ops echoOperations) Summary(summary string) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.Summary(summary) })
+}
+
+func (ops echoOperations) Description(description string) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.Description(description) })
+}
+
+func (ops echoOperations) Tags(tags ...string) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.Tags(tags...) })
+}
+
+func (ops echoOperations) Accepts(accepts ...string) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.Accepts(accepts...) })
+}
+
+func (ops echoOperations) Produces(produces ...string) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.Produces(produces...) })
+}
+
+func (ops echoOperations) Read(reads interface{}) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.Read(reads) })
+}
+
+func (ops echoOperations) ReadFieldDescriptions(descriptions map[string]string) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.ReadFieldDescriptions(descriptions) })
+}
+
+func (ops echoOperations) Returns(returns []models.ReturnType) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.Returns(returns) })
+}
+
+func (ops echoOperations) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.QueryParam(name, description, paramType, required, opts...) })
+}
+
+func (ops echoOperations) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.HeaderParam(name, description, paramType, required, opts...) })
+}
+
+func (ops echoOperations) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.PathParam(name, description, paramType, required, opts...) })
+}
+
+func (ops echoOperations) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.CookieParam(name, description, paramType, required, opts...) })
+}
+
+func (ops echoOperations) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.FormParam(name, description, paramType, required, opts...) })
+}
+
+func (ops echoOperations) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.FileParam(name, description, required, opts...) })
+}
+
+func (ops echoOperations) Params(v any) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.Params(v) })
+}
+
+func (ops echoOperations) Security(schemes ...string) models.Swagger {
+	return ops.each(func(r *echoRoute) { r.Security(schemes...) })
+}
@@ -1,6 +1,8 @@
 package echo
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/labstack/echo/v4"
@@ -82,9 +84,10 @@ func TestEchoSwagger_GET(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "GET",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "GET",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -120,9 +123,10 @@ func TestEchoSwagger_POST(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "POST",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "POST",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -158,9 +162,10 @@ func TestEchoSwagger_PUT(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "PUT",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "PUT",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -196,9 +201,10 @@ func TestEchoSwagger_DELETE(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "DELETE",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "DELETE",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -234,9 +240,10 @@ func TestEchoSwagger_PATCH(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "PATCH",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "PATCH",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -272,9 +279,10 @@ func TestEchoSwagger_OPTIONS(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "OPTIONS",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "OPTIONS",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -310,9 +318,10 @@ func TestEchoSwagger_HEAD(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "HEAD",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "HEAD",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -382,9 +391,10 @@ func TestEchoGroup_GET(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "GET",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "GET",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -420,9 +430,10 @@ func TestEchoGroup_POST(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "POST",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "POST",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -458,9 +469,10 @@ func TestEchoGroup_PUT(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "PUT",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "PUT",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -496,9 +508,10 @@ func TestEchoGroup_DELETE(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "DELETE",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "DELETE",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -534,9 +547,10 @@ func TestEchoGroup_PATCH(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "PATCH",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "PATCH",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -572,9 +586,10 @@ func TestEchoGroup_OPTIONS(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "OPTIONS",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "OPTIONS",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -610,9 +625,10 @@ func TestEchoGroup_HEAD(t *testing.T) {
 				m:    []echo.MiddlewareFunc{},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "HEAD",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "HEAD",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -1035,3 +1051,150 @@ func TestEchoRoute_PathParam(t *testing.T) {
 		})
 	}
 }
+
+func TestEchoSwagger_ServeDocs(t *testing.T) {
+	s := NewEcho()
+	s.ServeDocs("/docs")
+	// routes registered after the docs are also documented
+	s.Group("/users").GET("/:id", func(c echo.Context) error { return nil }).Summary("Get user")
+
+	for path, contains := range map[string]string{
+		"/docs":              "swagger-ui",
+		"/docs/redoc":        "redoc",
+		"/docs/openapi.json": `"/users/{id}"`,
+		"/docs/openapi.yaml": "summary: Get user",
+	} {
+		w := httptest.NewRecorder()
+		s.Echo().ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code, path)
+		assert.Contains(t, w.Body.String(), contains, path)
+	}
+
+	w := httptest.NewRecorder()
+	s.Echo().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs/openapi.json", nil))
+	assert.NotContains(t, w.Body.String(), `"/docs`)
+}
+
+func TestEchoSwagger_ServeDocsRoot(t *testing.T) {
+	s := NewEcho()
+	s.ServeDocs("/")
+	s.GET("/users/:id", func(c echo.Context) error { return nil })
+
+	// the other routes are reachable and the unmatched paths are not served by the docs
+	for path, want := range map[string]int{
+		"/":             http.StatusOK,
+		"/redoc":        http.StatusOK,
+		"/openapi.json": http.StatusOK,
+		"/users/1":      http.StatusOK,
+		"/unknown":      http.StatusNotFound,
+	} {
+		w := httptest.NewRecorder()
+		s.Echo().ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, want, w.Code, path)
+	}
+}
+
+func TestEchoSwagger_ValidationMiddleware(t *testing.T) {
+	s := NewEcho()
+	s.Echo().Use(s.ValidationMiddleware())
+	s.Group("/users").GET("/:id", func(c echo.Context) error { return c.NoContent(http.StatusOK) }).
+		PathParam("id", "user id", "int", true).
+		QueryParam("verbose", "verbose output", "bool", false)
+
+	for path, want := range map[string]int{
+		"/users/1":              http.StatusOK,
+		"/users/1?verbose=true": http.StatusOK,
+		"/users/a":              http.StatusBadRequest,
+		"/users/1?verbose=yes":  http.StatusBadRequest,
+	} {
+		w := httptest.NewRecorder()
+		s.Echo().ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, want, w.Code, path)
+	}
+}
+
+func TestNewEchoFrom(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = func(err error, c echo.Context) { _ = c.String(http.StatusTeapot, "custom") }
+
+	s := NewEchoFrom(e)
+	s.GET("/users/:id", func(c echo.Context) error { return echo.ErrNotFound }).Summary("Get user")
+
+	assert.Equal(t, e, s.Echo())
+	assert.Equal(t, "/users/{id}", s.routes[0].Route.Path)
+	assert.Equal(t, "Get user", s.routes[0].Route.Summary)
+
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users/1", nil))
+	assert.Equal(t, http.StatusTeapot, w.Code)
+}
+
+func TestNewEchoGroup(t *testing.T) {
+	e := echo.New()
+	s := NewEchoGroup(e, e.Group("/api"))
+	s.EchoGroup().Use(s.ValidationMiddleware())
+	s.ServeDocs("/docs")
+	s.Group("/users").GET("/:id", func(c echo.Context) error { return c.NoContent(http.StatusOK) }).
+		PathParam("id", "user id", "int", true)
+	s.POST("/login", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	assert.Equal(t, e, s.Echo())
+	assert.Equal(t, e, s.Handler())
+	assert.Equal(t, "/api/login", s.routes[0].Route.Path)
+	assert.Equal(t, "/api/users/{id}", s.groups[0].routes[0].Route.Path)
+
+	for path, want := range map[string]int{
+		"/api/users/1":           http.StatusOK,
+		"/api/users/a":           http.StatusBadRequest,
+		"/api/docs":              http.StatusOK,
+		"/api/docs/openapi.json": http.StatusOK,
+	} {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, want, w.Code, path)
+	}
+
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/docs/openapi.json", nil))
+	assert.Contains(t, w.Body.String(), `"/api/users/{id}"`)
+}
+
+func handleFile(c echo.Context) error {
+	return c.String(http.StatusOK, c.Param("id"))
+}
+
+func TestEchoSwagger_AnyMatch(t *testing.T) {
+	s := NewEcho()
+
+	files := s.Group("/files")
+	m := files.Match([]string{http.MethodGet, http.MethodHead}, "/:id", handleFile)
+	m.Summary("Get file").Tags("files")
+	m.Method(http.MethodHead).Summary("Check file")
+	m.Method(http.MethodPost).Summary("not documented")
+	s.Any("/echo", handleFile).Summary("Echo")
+
+	assert.Len(t, s.routes, len(generator.AnyMethods))
+	for i, r := range s.routes {
+		assert.Equal(t, generator.Route{Path: "/echo", Method: generator.AnyMethods[i], FuncName: "handleFile", Summary: "Echo"}, r.Route)
+	}
+
+	idParam := generator.Param{Name: "id", ParamType: "string", Required: true}
+	assert.Equal(t, []generator.Route{
+		{Path: "/files/{id}", Method: http.MethodGet, FuncName: "handleFile", Summary: "Get file", Tags: []string{"files"}, PathParams: []generator.Param{idParam}},
+		{Path: "/files/{id}", Method: http.MethodHead, FuncName: "handleFile", Summary: "Check file", Tags: []string{"files"}, PathParams: []generator.Param{idParam}},
+	}, toGoSwagRoute(s.groups[0].routes))
+
+	for method, want := range map[string]int{http.MethodGet: http.StatusOK, http.MethodPost: http.StatusMethodNotAllowed} {
+		w := httptest.NewRecorder()
+		s.Echo().ServeHTTP(w, httptest.NewRequest(method, "/files/42", nil))
+		assert.Equal(t, want, w.Code, method)
+	}
+
+	w := httptest.NewRecorder()
+	s.Echo().ServeHTTP(w, httptest.NewRequest(http.MethodTrace, "/echo", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
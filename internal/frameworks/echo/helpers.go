@@ -3,6 +3,7 @@ package echo
 import (
 	"strings"
 
+	"github.com/labstack/echo/v4"
 	"github.com/r0bertson/goswag/internal/generator"
 )
 
@@ -43,3 +44,30 @@ func toGoSwagGroup(from []*echoGroup) []generator.Group {
 
 	return groups
 }
+
+// toEchoOperations returns the routes of the methods in the order of the methods,
+// the other routes registered by echo are not documented.
+func toEchoOperations(routes []*echo.Route, methods []string) echoOperations {
+	operations := make(echoOperations, 0, len(methods))
+	for _, method := range methods {
+		for _, r := range routes {
+			if !strings.EqualFold(r.Method, method) {
+				continue
+			}
+
+			tpl, params := generator.PathTemplate(r.Path)
+			operations = append(operations, &echoRoute{
+				Route: generator.Route{
+					Path:       tpl,
+					Method:     r.Method,
+					FuncName:   getFuncName(r.Name),
+					PathParams: params,
+				},
+			})
+
+			break
+		}
+	}
+
+	return operations
+}
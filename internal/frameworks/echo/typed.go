@@ -0,0 +1,53 @@
+package echo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/r0bertson/goswag/internal/typed"
+	"github.com/r0bertson/goswag/models"
+)
+
+// Handle registers the typed handler for the method. The request is bound with c.Bind, so it is read
+// from the path, the query and the body as echo does, its fields with a uri or path tag are also bound
+// from the path, and the response is written as json.
+// The errors are returned to the error handler of echo, a models.StatusError becomes an *echo.HTTPError.
+func Handle[Req, Resp any](router models.EchoRouter, method, path string, h func(context.Context, Req) (Resp, error)) models.Swagger {
+	route := router.Match([]string{method}, path, func(c echo.Context) error {
+		var req Req
+		if !typed.IsEmpty(req) {
+			if err := c.Bind(&req); err != nil {
+				return err
+			}
+
+			if err := typed.BindPath(&req, c.Param); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+			}
+		}
+
+		resp, err := h(c.Request().Context(), req)
+		if err != nil {
+			var statusErr *models.StatusError
+			if errors.As(err, &statusErr) {
+				return echo.NewHTTPError(statusErr.Status, statusErr.Error()).SetInternal(err)
+			}
+
+			return err
+		}
+
+		if typed.IsEmpty(resp) {
+			return c.NoContent(typed.Status(method))
+		}
+
+		return c.JSON(typed.Status(method), resp)
+	}).Method(method)
+
+	if r, ok := route.(*echoRoute); ok {
+		r.Route.FuncName = typed.FuncName(h)
+		r.Route.Success = typed.Success[Resp](method)
+	}
+
+	return typed.Annotate[Req](route, method)
+}
@@ -0,0 +1,96 @@
+package echo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type createUser struct {
+	Name string `json:"name"`
+}
+
+type user struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func createUserHandler(ctx context.Context, req createUser) (user, error) {
+	if req.Name == "" {
+		return user{}, &models.StatusError{Status: http.StatusUnprocessableEntity, Err: errors.New("name is required")}
+	}
+
+	return user{ID: "1", Name: req.Name}, nil
+}
+
+func TestHandle(t *testing.T) {
+	s := NewEcho()
+	Handle(s.Group("/users"), http.MethodPost, "", createUserHandler).Summary("Create user")
+
+	route := s.groups[0].routes[0].Route
+	assert.Equal(t, "createUserHandler", route.FuncName)
+	assert.Equal(t, "Create user", route.Summary)
+	assert.Equal(t, createUser{}, route.Reads)
+	assert.Equal(t, &models.ReturnType{StatusCode: http.StatusCreated, Body: user{}}, route.Success)
+
+	tests := []struct {
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{body: `{"name":"Ada"}`, wantStatus: http.StatusCreated, wantBody: `{"id":"1","name":"Ada"}`},
+		{body: `{}`, wantStatus: http.StatusUnprocessableEntity, wantBody: `{"message":"name is required"}`},
+		{body: `{`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(tt.body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		w := httptest.NewRecorder()
+		s.Echo().ServeHTTP(w, req)
+
+		assert.Equal(t, tt.wantStatus, w.Code, tt.body)
+		if tt.wantBody != "" {
+			assert.JSONEq(t, tt.wantBody, w.Body.String(), tt.body)
+		}
+	}
+}
+
+type updateUser struct {
+	ID   int    `path:"id"`
+	Name string `json:"name"`
+}
+
+func updateUserHandler(ctx context.Context, req updateUser) (user, error) {
+	return user{ID: strconv.Itoa(req.ID), Name: req.Name}, nil
+}
+
+func TestHandle_pathParams(t *testing.T) {
+	s := NewEcho()
+	Handle(s.Group("/users"), http.MethodPut, "/:id", updateUserHandler).
+		Returns([]models.ReturnType{{StatusCode: http.StatusNotFound}})
+
+	route := s.groups[0].routes[0].Route
+	assert.Equal(t, &models.ReturnType{StatusCode: http.StatusOK, Body: user{}}, route.Success)
+	assert.Equal(t, []models.ReturnType{{StatusCode: http.StatusNotFound}}, route.Returns)
+
+	for path, want := range map[string]int{"/users/7": http.StatusOK, "/users/abc": http.StatusBadRequest} {
+		req := httptest.NewRequest(http.MethodPut, path, strings.NewReader(`{"name":"Ada"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		w := httptest.NewRecorder()
+		s.Echo().ServeHTTP(w, req)
+
+		assert.Equal(t, want, w.Code, path)
+		if want == http.StatusOK {
+			assert.JSONEq(t, `{"id":"7","name":"Ada"}`, w.Body.String())
+		}
+	}
+}
@@ -0,0 +1,416 @@
+package fiber
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/r0bertson/goswag/internal/docs"
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/models"
+)
+
+type fiberSwagger struct {
+	app              *fiber.App
+	groups           []*fiberGroup
+	routes           []*fiberRoute
+	defaultResponses []models.ReturnType
+	info             *models.Info
+}
+
+func NewFiber(app *fiber.App, defaultResponses ...models.ReturnType) *fiberSwagger {
+	return &fiberSwagger{
+		app:              app,
+		defaultResponses: defaultResponses,
+	}
+}
+
+func (s *fiberSwagger) App() *fiber.App {
+	return s.app
+}
+
+// Handler returns an http.Handler serving the routes with the fiber app, e.g. for httptest.
+func (s *fiberSwagger) Handler() http.Handler {
+	return adaptor.FiberApp(s.app)
+}
+
+// SetInfo sets the general information of the API written in the generated documentation.
+func (s *fiberSwagger) SetInfo(info models.Info) {
+	s.info = &info
+}
+
+func (s *fiberSwagger) GenerateSwagger() {
+	generator.GenerateSwagger(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
+}
+
+func (s *fiberSwagger) GenerateSwaggerE(opts models.GenerateOptions) error {
+	if opts.Info == nil {
+		opts.Info = s.info
+	}
+
+	return generator.Generate(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, opts)
+}
+
+func (s *fiberSwagger) GenerateOpenAPI() {
+	generator.GenerateOpenAPI(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
+}
+
+// ServeDocs mounts the Swagger UI, Redoc and the OpenAPI document of the routes under the prefix.
+// The docs routes are registered directly in the app, so they are not documented.
+func (s *fiberSwagger) ServeDocs(prefix string) {
+	h := adaptor.HTTPHandler(docs.Handler(prefix, s.OpenAPI))
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		// the routes are matched in order, a catch-all at the root would hide the next ones
+		for _, path := range docs.Paths {
+			s.app.Get(path, h)
+		}
+
+		return
+	}
+
+	s.app.Get(prefix, h)
+	s.app.Get(prefix+"/*", h)
+}
+
+// OpenAPI returns the json of the OpenAPI 3.1 document of the routes registered so far.
+func (s *fiberSwagger) OpenAPI() ([]byte, error) {
+	return generator.MarshalOpenAPI(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
+}
+
+func (s *fiberSwagger) Use(args ...interface{}) models.FiberRouter {
+	s.app.Use(args...)
+	return s
+}
+
+func (s *fiberSwagger) Group(prefix string, handlers ...fiber.Handler) models.FiberRouter {
+	g := &fiberGroup{g: s.app.Group(prefix, handlers...), prefix: prefix, groupName: prefix}
+	s.groups = append(s.groups, g)
+
+	return g
+}
+
+func (s *fiberSwagger) handle(method, path string, handlers ...fiber.Handler) models.Swagger {
+	s.app.Add(method, path, handlers...)
+	return s.document([]string{method}, path, handlers...)
+}
+
+// document adds one route per method and path, the handlers are registered by the caller.
+func (s *fiberSwagger) document(methods []string, path string, handlers ...fiber.Handler) fiberOperations {
+	operations := newOperations(methods, path, handlers...)
+	s.routes = append(s.routes, operations...)
+
+	return operations
+}
+
+// Any registers the route for every method, the methods of generator.AnyMethods are documented.
+func (s *fiberSwagger) Any(path string, handlers ...fiber.Handler) models.MultiSwagger {
+	s.app.All(path, handlers...)
+	return s.document(generator.AnyMethods, path, handlers...)
+}
+
+func (s *fiberSwagger) Match(methods []string, path string, handlers ...fiber.Handler) models.MultiSwagger {
+	for _, method := range methods {
+		s.app.Add(strings.ToUpper(method), path, handlers...)
+	}
+
+	return s.document(methods, path, handlers...)
+}
+
+func (s *fiberSwagger) Get(path string, handlers ...fiber.Handler) models.Swagger {
+	return s.handle(fiber.MethodGet, path, handlers...)
+}
+
+func (s *fiberSwagger) Post(path string, handlers ...fiber.Handler) models.Swagger {
+	return s.handle(fiber.MethodPost, path, handlers...)
+}
+
+func (s *fiberSwagger) Put(path string, handlers ...fiber.Handler) models.Swagger {
+	return s.handle(fiber.MethodPut, path, handlers...)
+}
+
+func (s *fiberSwagger) Delete(path string, handlers ...fiber.Handler) models.Swagger {
+	return s.handle(fiber.MethodDelete, path, handlers...)
+}
+
+func (s *fiberSwagger) Patch(path string, handlers ...fiber.Handler) models.Swagger {
+	return s.handle(fiber.MethodPatch, path, handlers...)
+}
+
+func (s *fiberSwagger) Options(path string, handlers ...fiber.Handler) models.Swagger {
+	return s.handle(fiber.MethodOptions, path, handlers...)
+}
+
+func (s *fiberSwagger) Head(path string, handlers ...fiber.Handler) models.Swagger {
+	return s.handle(fiber.MethodHead, path, handlers...)
+}
+
+type fiberGroup struct {
+	g         fiber.Router
+	prefix    string
+	groupName string
+	groups    []*fiberGroup
+	routes    []*fiberRoute
+}
+
+func (g *fiberGroup) Use(args ...interface{}) models.FiberRouter {
+	g.g.Use(args...)
+	return g
+}
+
+// Group creates a new sub-group with prefix and optional sub-group-level middleware.
+func (g *fiberGroup) Group(prefix string, handlers ...fiber.Handler) models.FiberRouter {
+	sub := &fiberGroup{g: g.g.Group(prefix, handlers...), prefix: getFullPath(g.prefix, prefix), groupName: prefix}
+	g.groups = append(g.groups, sub)
+
+	return sub
+}
+
+func (g *fiberGroup) handle(method, path string, handlers ...fiber.Handler) models.Swagger {
+	g.g.Add(method, path, handlers...)
+	return g.document([]string{method}, path, handlers...)
+}
+
+// document adds one route per method and path, the handlers are registered by the caller.
+func (g *fiberGroup) document(methods []string, path string, handlers ...fiber.Handler) fiberOperations {
+	operations := newOperations(methods, getFullPath(g.prefix, path), handlers...)
+	g.routes = append(g.routes, operations...)
+
+	return operations
+}
+
+// Any registers the route for every method, the methods of generator.AnyMethods are documented.
+func (g *fiberGroup) Any(path string, handlers ...fiber.Handler) models.MultiSwagger {
+	g.g.All(path, handlers...)
+	return g.document(generator.AnyMethods, path, handlers...)
+}
+
+func (g *fiberGroup) Match(methods []string, path string, handlers ...fiber.Handler) models.MultiSwagger {
+	for _, method := range methods {
+		g.g.Add(strings.ToUpper(method), path, handlers...)
+	}
+
+	return g.document(methods, path, handlers...)
+}
+
+func (g *fiberGroup) Get(path string, handlers ...fiber.Handler) models.Swagger {
+	return g.handle(fiber.MethodGet, path, handlers...)
+}
+
+func (g *fiberGroup) Post(path string, handlers ...fiber.Handler) models.Swagger {
+	return g.handle(fiber.MethodPost, path, handlers...)
+}
+
+func (g *fiberGroup) Put(path string, handlers ...fiber.Handler) models.Swagger {
+	return g.handle(fiber.MethodPut, path, handlers...)
+}
+
+func (g *fiberGroup) Delete(path string, handlers ...fiber.Handler) models.Swagger {
+	return g.handle(fiber.MethodDelete, path, handlers...)
+}
+
+func (g *fiberGroup) Patch(path string, handlers ...fiber.Handler) models.Swagger {
+	return g.handle(fiber.MethodPatch, path, handlers...)
+}
+
+func (g *fiberGroup) Options(path string, handlers ...fiber.Handler) models.Swagger {
+	return g.handle(fiber.MethodOptions, path, handlers...)
+}
+
+func (g *fiberGroup) Head(path string, handlers ...fiber.Handler) models.Swagger {
+	return g.handle(fiber.MethodHead, path, handlers...)
+}
+
+type fiberRoute struct {
+	Route generator.Route
+	// omitted are the optional path parameters left out of the path of the route,
+	// their annotations only apply to the route with the full path.
+	omitted []string
+}
+
+func (r *fiberRoute) Summary(summary string) models.Swagger {
+	r.Route.Summary = summary
+	return r
+}
+
+func (r *fiberRoute) Description(description string) models.Swagger {
+	r.Route.Description = description
+	return r
+}
+
+func (r *fiberRoute) Tags(tags ...string) models.Swagger {
+	r.Route.Tags = tags
+	return r
+}
+
+func (r *fiberRoute) Accepts(accepts ...string) models.Swagger {
+	r.Route.Accepts = accepts
+	return r
+}
+
+func (r *fiberRoute) Produces(produces ...string) models.Swagger {
+	r.Route.Produces = produces
+	return r
+}
+
+func (r *fiberRoute) Read(reads interface{}) models.Swagger {
+	r.Route.Reads = reads
+	return r
+}
+
+func (r *fiberRoute) ReadFieldDescriptions(descriptions map[string]string) models.Swagger {
+	r.Route.ReadFieldDescriptions = descriptions
+	return r
+}
+
+func (r *fiberRoute) Returns(returns []models.ReturnType) models.Swagger {
+	r.Route.Returns = returns
+	return r
+}
+
+func (r *fiberRoute) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.QueryParams = append(r.Route.QueryParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *fiberRoute) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.HeaderParams = append(r.Route.HeaderParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+// PathParam refines the parameter declared from the path variable with the same name.
+func (r *fiberRoute) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	if !slices.Contains(r.omitted, name) {
+		r.Route.PathParams = generator.SetParam(r.Route.PathParams, generator.NewParam(name, description, paramType, required, opts...))
+	}
+
+	return r
+}
+
+func (r *fiberRoute) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.CookieParams = append(r.Route.CookieParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *fiberRoute) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *fiberRoute) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, "file", required, opts...))
+	return r
+}
+
+// Params declares the query, header and path parameters read from the tags of the fields of a struct.
+func (r *fiberRoute) Params(v any) models.Swagger {
+	path, query, header := generator.StructParams(v, r.Route.Method)
+	path = slices.DeleteFunc(path, func(p generator.Param) bool { return slices.Contains(r.omitted, p.Name) })
+	r.Route.PathParams = generator.SetParam(r.Route.PathParams, path...)
+	r.Route.QueryParams = generator.SetParam(r.Route.QueryParams, query...)
+	r.Route.HeaderParams = generator.SetParam(r.Route.HeaderParams, header...)
+
+	return r
+}
+
+func (r *fiberRoute) Security(schemes ...string) models.Swagger {
+	r.Route.Security = append(r.Route.Security, schemes...)
+	return r
+}
+
+// fiberOperations applies the annotations to the routes of all the methods of a path.
+type fiberOperations []*fiberRoute
+
+func (ops fiberOperations) each(fn func(r *fiberRoute)) models.Swagger {
+	for _, r := range ops {
+		fn(r)
+	}
+
+	return ops
+}
+
+// Method returns the routes of the method, one per path when the path has optional parameters,
+// or a detached one that is not documented.
+func (ops fiberOperations) Method(method string) models.Swagger {
+	var routes fiberOperations
+	for _, r := range ops {
+		if strings.EqualFold(r.Route.Method, method) {
+			routes = append(routes, r)
+		}
+	}
+
+	switch len(routes) {
+	case 0:
+		return &fiberRoute{}
+	case 1:
+		return routes[0]
+	default:
+		return routes
+	}
+}
+
+func (ops fiberOperations) Summary(summary string) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.Summary(summary) })
+}
+
+func (ops fiberOperations) Description(description string) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.Description(description) })
+}
+
+func (ops fiberOperations) Tags(tags ...string) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.Tags(tags...) })
+}
+
+func (ops fiberOperations) Accepts(accepts ...string) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.Accepts(accepts...) })
+}
+
+func (ops fiberOperations) Produces(produces ...string) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.Produces(produces...) })
+}
+
+func (ops fiberOperations) Read(reads interface{}) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.Read(reads) })
+}
+
+func (ops fiberOperations) ReadFieldDescriptions(descriptions map[string]string) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.ReadFieldDescriptions(descriptions) })
+}
+
+func (ops fiberOperations) Returns(returns []models.ReturnType) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.Returns(returns) })
+}
+
+func (ops fiberOperations) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.QueryParam(name, description, paramType, required, opts...) })
+}
+
+func (ops fiberOperations) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.HeaderParam(name, description, paramType, required, opts...) })
+}
+
+func (ops fiberOperations) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.PathParam(name, description, paramType, required, opts...) })
+}
+
+func (ops fiberOperations) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.CookieParam(name, description, paramType, required, opts...) })
+}
+
+func (ops fiberOperations) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.FormParam(name, description, paramType, required, opts...) })
+}
+
+func (ops fiberOperations) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.FileParam(name, description, required, opts...) })
+}
+
+func (ops fiberOperations) Params(v any) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.Params(v) })
+}
+
+func (ops fiberOperations) Security(schemes ...string) models.Swagger {
+	return ops.each(func(r *fiberRoute) { r.Security(schemes...) })
+}
@@ -0,0 +1,220 @@
+package fiber
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func handleGetUser(c *fiber.Ctx) error {
+	return c.SendString(c.Params("id"))
+}
+
+func TestNewFiber(t *testing.T) {
+	app := fiber.New()
+	s := NewFiber(app)
+
+	assert.Equal(t, app, s.App())
+}
+
+func TestFiberSwagger_routes(t *testing.T) {
+	s := NewFiber(fiber.New())
+	auth := func(c *fiber.Ctx) error { return c.Next() }
+
+	s.Use(auth)
+	s.Get("/health", handleGetUser)
+	v1 := s.Group("/v1")
+	users := v1.Group("/users", auth)
+	users.Get("/:id", handleGetUser).Summary("Get user")
+	users.Delete("/:id<int>", auth, handleGetUser)
+	users.Post("/", handleGetUser)
+	v1.Patch("/files/*", handleGetUser)
+
+	assert.Equal(t, []generator.Route{{Path: "/health", Method: http.MethodGet, FuncName: "handleGetUser"}}, toGoSwagRoute(s.routes))
+	assert.Equal(t, []generator.Group{
+		{
+			GroupName: "/v1",
+			Routes:    []generator.Route{{Path: "/v1/files/{wildcard}", Method: http.MethodPatch, FuncName: "handleGetUser", PathParams: []generator.Param{{Name: "wildcard", ParamType: "string", Required: true}}}},
+			Groups: []generator.Group{
+				{
+					GroupName: "/users",
+					Routes: []generator.Route{
+						{Path: "/v1/users/{id}", Method: http.MethodGet, FuncName: "handleGetUser", Summary: "Get user", PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}}},
+						{Path: "/v1/users/{id}", Method: http.MethodDelete, FuncName: "handleGetUser", PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}}},
+						{Path: "/v1/users/", Method: http.MethodPost, FuncName: "handleGetUser"},
+					},
+				},
+			},
+		},
+	}, toGoSwagGroup(s.groups))
+
+	resp, err := s.App().Test(httptest.NewRequest(http.MethodGet, "/v1/users/42", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "42", string(body))
+}
+
+func TestToSwagPaths(t *testing.T) {
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{path: "/users", want: []string{"/users"}},
+		{path: "/users/:id", want: []string{"/users/{id}"}},
+		{path: "/users/:id?", want: []string{"/users/{id}", "/users"}},
+		{path: "/:id?", want: []string{"/{id}", "/"}},
+		{path: "/users/:id?/posts", want: []string{"/users/{id}/posts", "/users/posts"}},
+		{path: "/users/:id<int>/posts/:post<min(1)>", want: []string{"/users/{id}/posts/{post}"}},
+		{path: "/flights/:from-:to", want: []string{"/flights/{from}-{to}"}},
+		{path: "/plants/:genus.:species?", want: []string{"/plants/{genus}.{species}", "/plants/{genus}"}},
+		{path: "/files/*", want: []string{"/files/{wildcard}"}},
+		{path: "/files/*.txt", want: []string{"/files/{wildcard}.txt"}},
+		{path: "/files/+/*", want: []string{"/files/{wildcard}/{wildcard2}"}},
+		{path: "/v1/\\:action", want: []string{"/v1/:action"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, toSwagPaths(tt.path))
+		})
+	}
+}
+
+func TestFiberSwagger_optionalParams(t *testing.T) {
+	s := NewFiber(fiber.New())
+	s.Get("/users/:id?", handleGetUser).Summary("Get users").PathParam("id", "user id", "int", true)
+
+	assert.Equal(t, []generator.Route{
+		{Path: "/users/{id}", Method: http.MethodGet, FuncName: "handleGetUser", Summary: "Get users", PathParams: []generator.Param{{Name: "id", Description: "user id", ParamType: "int", Required: true}}},
+		{Path: "/users", Method: http.MethodGet, FuncName: "handleGetUser", Summary: "Get users"},
+	}, toGoSwagRoute(s.routes))
+
+	for _, path := range []string{"/users", "/users/42"} {
+		resp, err := s.App().Test(httptest.NewRequest(http.MethodGet, path, nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, path)
+	}
+}
+
+func TestFiberSwagger_OpenAPI(t *testing.T) {
+	s := NewFiber(fiber.New())
+	s.Group("/users").Get("/:id", handleGetUser).PathParam("id", "user id", "int", true)
+
+	content, err := s.OpenAPI()
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), `"/users/{id}"`)
+	assert.Contains(t, string(content), `"/users"`)
+}
+
+func TestFiberSwagger_ServeDocs(t *testing.T) {
+	s := NewFiber(fiber.New())
+	s.ServeDocs("/docs")
+	s.Get("/users/:id", handleGetUser).Summary("Get user")
+
+	for path, contains := range map[string]string{
+		"/docs":              "swagger-ui",
+		"/docs/redoc":        "redoc",
+		"/docs/openapi.json": `"/users/{id}"`,
+		"/docs/openapi.yaml": "summary: Get user",
+	} {
+		resp, err := s.App().Test(httptest.NewRequest(http.MethodGet, path, nil))
+		assert.NoError(t, err, path)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, path)
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), contains, path)
+	}
+}
+
+func TestFiberSwagger_ServeDocsRoot(t *testing.T) {
+	s := NewFiber(fiber.New())
+	s.ServeDocs("/")
+	s.Get("/users/:id", handleGetUser)
+
+	// the other routes are still reachable next to the docs served at the root
+	for path, contains := range map[string]string{
+		"/":             "swagger-ui",
+		"/redoc":        "redoc",
+		"/openapi.json": `"/users/{id}"`,
+		"/users/1":      "",
+	} {
+		resp, err := s.App().Test(httptest.NewRequest(http.MethodGet, path, nil))
+		assert.NoError(t, err, path)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, path)
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), contains, path)
+	}
+}
+
+func TestFiberRoute(t *testing.T) {
+	r := &fiberRoute{}
+	r.Summary("summary").
+		Description("description").
+		Tags("users").
+		Accepts("json").
+		Produces("xml").
+		Read(struct{}{}).
+		ReadFieldDescriptions(map[string]string{"name": "Name"}).
+		Returns([]models.ReturnType{{StatusCode: http.StatusOK}}).
+		QueryParam("q", "query", "string", false).
+		HeaderParam("h", "header", "string", true).
+		PathParam("id", "id", "int", true).
+		Security("BearerAuth")
+
+	assert.Equal(t, generator.Route{
+		Summary:               "summary",
+		Description:           "description",
+		Tags:                  []string{"users"},
+		Accepts:               []string{"json"},
+		Produces:              []string{"xml"},
+		Reads:                 struct{}{},
+		ReadFieldDescriptions: map[string]string{"name": "Name"},
+		Returns:               []models.ReturnType{{StatusCode: http.StatusOK}},
+		QueryParams:           []generator.Param{{Name: "q", Description: "query", ParamType: "string"}},
+		HeaderParams:          []generator.Param{{Name: "h", Description: "header", ParamType: "string", Required: true}},
+		PathParams:            []generator.Param{{Name: "id", Description: "id", ParamType: "int", Required: true}},
+		Security:              []string{"BearerAuth"},
+	}, r.Route)
+}
+
+func TestFiberSwagger_AnyMatch(t *testing.T) {
+	s := NewFiber(fiber.New())
+
+	files := s.Group("/files")
+	m := files.Match([]string{"get", http.MethodHead}, "/:id", handleGetUser)
+	m.Summary("Get file").Tags("files")
+	m.Method(http.MethodHead).Summary("Check file")
+	m.Method(http.MethodPost).Summary("not documented")
+	s.Any("/echo", handleGetUser).Summary("Echo")
+
+	routes := toGoSwagRoute(s.routes)
+	assert.Len(t, routes, len(generator.AnyMethods))
+	for i, r := range routes {
+		assert.Equal(t, generator.Route{Path: "/echo", Method: generator.AnyMethods[i], FuncName: "handleGetUser", Summary: "Echo"}, r)
+	}
+
+	idParam := generator.Param{Name: "id", ParamType: "string", Required: true}
+	assert.Equal(t, []generator.Route{
+		{Path: "/files/{id}", Method: http.MethodGet, FuncName: "handleGetUser", Summary: "Get file", Tags: []string{"files"}, PathParams: []generator.Param{idParam}},
+		{Path: "/files/{id}", Method: http.MethodHead, FuncName: "handleGetUser", Summary: "Check file", Tags: []string{"files"}, PathParams: []generator.Param{idParam}},
+	}, toGoSwagGroup(s.groups)[0].Routes)
+
+	for method, want := range map[string]int{http.MethodGet: http.StatusOK, http.MethodPost: http.StatusMethodNotAllowed} {
+		resp, err := s.App().Test(httptest.NewRequest(method, "/files/42", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, want, resp.StatusCode, method)
+	}
+
+	resp, err := s.App().Test(httptest.NewRequest(http.MethodTrace, "/echo", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,188 @@
+package fiber
+
+import (
+	"path"
+	"reflect"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/r0bertson/goswag/internal/generator"
+)
+
+// getFuncName retrieves the name of the function associated with the last handler in the given list of fiber.Handler.
+// It uses the reflect package to obtain the function name from the pointer value of the last handler.
+// The function name is extracted by splitting the full function name string using the dot separator and returning the last element.
+// The retrieved function name is then returned as a string.
+func getFuncName(handlers ...fiber.Handler) string {
+	if len(handlers) == 0 {
+		return ""
+	}
+
+	lastHandler := handlers[len(handlers)-1]
+
+	fullFuncName := runtime.FuncForPC(reflect.ValueOf(lastHandler).Pointer()).Name()
+	funcNameSplit := strings.Split(fullFuncName, ".")
+	funcName := funcNameSplit[len(funcNameSplit)-1]
+	funcName = strings.TrimSuffix(funcName, "-fm")
+
+	return funcName
+}
+
+// paramDelimiters end the name of a fiber parameter, so several parameters can share a segment like :from-:to.
+const paramDelimiters = "/-.:<?\\"
+
+// toSwagPaths translates the fiber path syntax to path templates: the parameters :id and
+// constrained :id<int> become {id}, also inside a segment like :from-:to or :genus.:species,
+// and the wildcards * and + become {wildcard}, {wildcard2}... An optional parameter :id? matches
+// the path with and without it, so both are returned, the path with all the parameters first.
+func toSwagPaths(fiberPath string) []string {
+	paths := []string{""}
+	add := func(text string) {
+		for i := range paths {
+			paths[i] += text
+		}
+	}
+
+	wildcards := 0
+	for i := 0; i < len(fiberPath); {
+		switch c := fiberPath[i]; {
+		case c == '\\' && i+1 < len(fiberPath):
+			// escaped characters like \: are part of the path
+			add(fiberPath[i+1 : i+2])
+			i += 2
+		case c == ':':
+			end := i + 1
+			for end < len(fiberPath) && !strings.ContainsRune(paramDelimiters, rune(fiberPath[end])) {
+				end++
+			}
+
+			name := fiberPath[i+1 : end]
+			if end < len(fiberPath) && fiberPath[end] == '<' {
+				if j := strings.IndexByte(fiberPath[end:], '>'); j >= 0 {
+					end += j + 1
+				} else {
+					end = len(fiberPath)
+				}
+			}
+
+			var without []string
+			if end < len(fiberPath) && fiberPath[end] == '?' {
+				end++
+				for _, p := range paths {
+					without = append(without, trimDelimiter(p))
+				}
+			}
+
+			add("{" + name + "}")
+			paths = append(paths, without...)
+			i = end
+		case c == '*' || c == '+':
+			wildcards++
+
+			name := "wildcard"
+			if wildcards > 1 {
+				name += strconv.Itoa(wildcards)
+			}
+
+			add("{" + name + "}")
+			i++
+		default:
+			add(string(c))
+			i++
+		}
+	}
+
+	for i, p := range paths {
+		if p == "" {
+			paths[i] = "/"
+		}
+	}
+
+	return paths
+}
+
+// newOperations returns the routes of the methods for every path template of the fiber path.
+func newOperations(methods []string, fiberPath string, handlers ...fiber.Handler) fiberOperations {
+	paths := toSwagPaths(fiberPath)
+	_, all := generator.PathTemplate(paths[0])
+
+	var operations fiberOperations
+	for _, method := range methods {
+		for _, path := range paths {
+			tpl, params := generator.PathTemplate(path)
+
+			var omitted []string
+			for _, p := range all {
+				if !slices.ContainsFunc(params, func(param generator.Param) bool { return param.Name == p.Name }) {
+					omitted = append(omitted, p.Name)
+				}
+			}
+
+			operations = append(operations, &fiberRoute{
+				Route: generator.Route{
+					Path:       tpl,
+					Method:     strings.ToUpper(method),
+					FuncName:   getFuncName(handlers...),
+					PathParams: params,
+				},
+				omitted: omitted,
+			})
+		}
+	}
+
+	return operations
+}
+
+// trimDelimiter removes the delimiter at the end of the path, it goes with the optional parameter that follows it.
+func trimDelimiter(path string) string {
+	if path != "" && strings.ContainsRune("/-.", rune(path[len(path)-1])) {
+		return path[:len(path)-1]
+	}
+
+	return path
+}
+
+// toGoSwagRoute converts a slice of fiberRoute to a slice of generator.Route.
+// It iterates over each fiberRoute in the input slice and appends its Route field to the output slice.
+// Returns the converted slice of generator.Route.
+func toGoSwagRoute(from []*fiberRoute) []generator.Route {
+	var routes []generator.Route
+	for _, r := range from {
+		routes = append(routes, r.Route)
+	}
+
+	return routes
+}
+
+// toGoSwagGroup converts a slice of fiberGroup objects to a slice of generator.Group.
+// It iterates over each fiberGroup and creates a generator.Group object with the corresponding properties.
+// The converted generator.Group objects are then returned as a slice.
+func toGoSwagGroup(from []*fiberGroup) []generator.Group {
+	var groups []generator.Group
+	for _, g := range from {
+		groups = append(groups, generator.Group{
+			GroupName: g.groupName,
+			Routes:    toGoSwagRoute(g.routes),
+			Groups:    toGoSwagGroup(g.groups),
+		})
+	}
+
+	return groups
+}
+
+func getFullPath(groupName, relativePath string) string {
+	if groupName == "" {
+		return relativePath
+	}
+
+	fullPath := path.Join(groupName, relativePath)
+
+	if strings.HasSuffix(relativePath, "/") {
+		fullPath += "/"
+	}
+
+	return fullPath
+}
@@ -2,9 +2,12 @@ package gin
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/r0bertson/goswag/internal/docs"
 	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/internal/validator"
 	"github.com/r0bertson/goswag/models"
 )
 
@@ -13,6 +16,7 @@ type ginSwagger struct {
 	groups           []*ginGroup
 	routes           []*ginRoute
 	defaultResponses []models.ReturnType
+	info             *models.Info
 }
 
 func NewGin(g *gin.Engine, defaultResponses ...models.ReturnType) *ginSwagger {
@@ -26,12 +30,74 @@ func (s *ginSwagger) Gin() *gin.Engine {
 	return s.g
 }
 
+// Handler returns the gin engine serving the routes, e.g. for httptest.
+func (s *ginSwagger) Handler() http.Handler {
+	return s.g
+}
+
+// SetInfo sets the general information of the API written in the generated documentation.
+func (s *ginSwagger) SetInfo(info models.Info) {
+	s.info = &info
+}
+
 func (s *ginSwagger) GenerateSwagger() {
-	generator.GenerateSwagger(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses)
+	generator.GenerateSwagger(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
 }
 
-func (s *ginSwagger) Group(relativePath string, handlers ...gin.HandlerFunc) models.GinRouter {
-	g := &ginGroup{gg: s.g.Group(relativePath, handlers...), groupName: relativePath}
+func (s *ginSwagger) GenerateSwaggerE(opts models.GenerateOptions) error {
+	if opts.Info == nil {
+		opts.Info = s.info
+	}
+
+	return generator.Generate(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, opts)
+}
+
+func (s *ginSwagger) GenerateOpenAPI() {
+	generator.GenerateOpenAPI(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
+}
+
+// ServeDocs mounts the Swagger UI, Redoc and the OpenAPI document of the routes under the prefix.
+// The docs routes are registered directly in the router, so they are not documented.
+func (s *ginSwagger) ServeDocs(prefix string) {
+	h := gin.WrapH(docs.Handler(prefix, s.OpenAPI))
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		// a catch-all at the root would conflict with every other route
+		for _, path := range docs.Paths {
+			s.g.GET(path, h)
+		}
+
+		return
+	}
+
+	s.g.GET(prefix, h)
+	s.g.GET(prefix+"/*any", h)
+}
+
+// ValidationMiddleware returns a middleware that rejects the requests that do not match the
+// documentation of their route. It must be registered with Gin().Use before the routes.
+func (s *ginSwagger) ValidationMiddleware(opts ...models.ValidationOptions) gin.HandlerFunc {
+	registry := validator.NewRegistry(func() ([]generator.Route, []generator.Group) {
+		return toGoSwagRoute(s.routes), toGoSwagGroup(s.groups)
+	}, opts...)
+
+	return func(c *gin.Context) {
+		if !registry.Check(c.Writer, c.Request, c.FullPath(), c.Param) {
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// OpenAPI returns the json of the OpenAPI 3.1 document of the routes registered so far.
+func (s *ginSwagger) OpenAPI() ([]byte, error) {
+	return generator.MarshalOpenAPI(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
+}
+
+func (s *ginSwagger) Group(relativePath string, handlers ...gin.HandlerFunc) models.GinGroup {
+	g := &ginGroup{gg: s.g.Group(relativePath, handlers...), prefix: relativePath, groupName: relativePath}
 	s.groups = append(s.groups, g)
 
 	return g
@@ -40,11 +106,13 @@ func (s *ginSwagger) Group(relativePath string, handlers ...gin.HandlerFunc) mod
 func (s *ginSwagger) Handle(httpMethod, relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	s.g.Handle(httpMethod, relativePath, handlers...)
 
+	tpl, params := generator.PathTemplate(relativePath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     relativePath,
-			Method:   httpMethod,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     httpMethod,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -53,14 +121,48 @@ func (s *ginSwagger) Handle(httpMethod, relativePath string, handlers ...gin.Han
 	return gr
 }
 
+// Any registers the route for every method, the methods of generator.AnyMethods are documented.
+func (s *ginSwagger) Any(relativePath string, handlers ...gin.HandlerFunc) models.MultiSwagger {
+	s.g.Any(relativePath, handlers...)
+	return s.document(generator.AnyMethods, relativePath, handlers...)
+}
+
+func (s *ginSwagger) Match(methods []string, relativePath string, handlers ...gin.HandlerFunc) models.MultiSwagger {
+	s.g.Match(methods, relativePath, handlers...)
+	return s.document(methods, relativePath, handlers...)
+}
+
+// document adds one route per method, the handlers are registered by the caller.
+func (s *ginSwagger) document(methods []string, relativePath string, handlers ...gin.HandlerFunc) ginOperations {
+	operations := make(ginOperations, 0, len(methods))
+	for _, method := range methods {
+		tpl, params := generator.PathTemplate(relativePath)
+		gr := &ginRoute{
+			Route: generator.Route{
+				Path:       tpl,
+				Method:     strings.ToUpper(method),
+				FuncName:   getFuncName(handlers...),
+				PathParams: params,
+			},
+		}
+
+		s.routes = append(s.routes, gr)
+		operations = append(operations, gr)
+	}
+
+	return operations
+}
+
 func (s *ginSwagger) POST(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	s.g.POST(relativePath, handlers...)
 
+	tpl, params := generator.PathTemplate(relativePath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     relativePath,
-			Method:   http.MethodPost,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodPost,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -72,11 +174,13 @@ func (s *ginSwagger) POST(relativePath string, handlers ...gin.HandlerFunc) mode
 func (s *ginSwagger) GET(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	s.g.GET(relativePath, handlers...)
 
+	tpl, params := generator.PathTemplate(relativePath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     relativePath,
-			Method:   http.MethodGet,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodGet,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -88,11 +192,13 @@ func (s *ginSwagger) GET(relativePath string, handlers ...gin.HandlerFunc) model
 func (s *ginSwagger) PUT(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	s.g.PUT(relativePath, handlers...)
 
+	tpl, params := generator.PathTemplate(relativePath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     relativePath,
-			Method:   http.MethodPut,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodPut,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -104,11 +210,13 @@ func (s *ginSwagger) PUT(relativePath string, handlers ...gin.HandlerFunc) model
 func (s *ginSwagger) DELETE(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	s.g.DELETE(relativePath, handlers...)
 
+	tpl, params := generator.PathTemplate(relativePath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     relativePath,
-			Method:   http.MethodDelete,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodDelete,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -120,11 +228,13 @@ func (s *ginSwagger) DELETE(relativePath string, handlers ...gin.HandlerFunc) mo
 func (s *ginSwagger) PATCH(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	s.g.PATCH(relativePath, handlers...)
 
+	tpl, params := generator.PathTemplate(relativePath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     relativePath,
-			Method:   http.MethodPatch,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodPatch,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -136,11 +246,13 @@ func (s *ginSwagger) PATCH(relativePath string, handlers ...gin.HandlerFunc) mod
 func (s *ginSwagger) OPTIONS(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	s.g.OPTIONS(relativePath, handlers...)
 
+	tpl, params := generator.PathTemplate(relativePath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     relativePath,
-			Method:   http.MethodOptions,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodOptions,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -152,11 +264,13 @@ func (s *ginSwagger) OPTIONS(relativePath string, handlers ...gin.HandlerFunc) m
 func (s *ginSwagger) HEAD(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	s.g.HEAD(relativePath, handlers...)
 
+	tpl, params := generator.PathTemplate(relativePath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     relativePath,
-			Method:   http.MethodHead,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodHead,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -167,19 +281,32 @@ func (s *ginSwagger) HEAD(relativePath string, handlers ...gin.HandlerFunc) mode
 
 type ginGroup struct {
 	gg        *gin.RouterGroup
+	prefix    string
 	groupName string
+	groups    []*ginGroup
 	routes    []*ginRoute
 }
 
+// Group creates a new sub-group with prefix and optional sub-group-level middleware,
+// gin runs the middlewares of the parent groups before them.
+func (g *ginGroup) Group(relativePath string, handlers ...gin.HandlerFunc) models.GinGroup {
+	sub := &ginGroup{gg: g.gg.Group(relativePath, handlers...), prefix: getFullPath(g.prefix, relativePath), groupName: relativePath}
+	g.groups = append(g.groups, sub)
+
+	return sub
+}
+
 func (g *ginGroup) Handle(httpMethod, relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	g.gg.Handle(httpMethod, relativePath, handlers...)
-	fullPath := getFullPath(g.groupName, relativePath)
+	fullPath := getFullPath(g.prefix, relativePath)
 
+	tpl, params := generator.PathTemplate(fullPath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     fullPath,
-			Method:   httpMethod,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     httpMethod,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -188,15 +315,49 @@ func (g *ginGroup) Handle(httpMethod, relativePath string, handlers ...gin.Handl
 	return gr
 }
 
+// Any registers the route for every method, the methods of generator.AnyMethods are documented.
+func (g *ginGroup) Any(relativePath string, handlers ...gin.HandlerFunc) models.MultiSwagger {
+	g.gg.Any(relativePath, handlers...)
+	return g.document(generator.AnyMethods, relativePath, handlers...)
+}
+
+func (g *ginGroup) Match(methods []string, relativePath string, handlers ...gin.HandlerFunc) models.MultiSwagger {
+	g.gg.Match(methods, relativePath, handlers...)
+	return g.document(methods, relativePath, handlers...)
+}
+
+// document adds one route per method, the handlers are registered by the caller.
+func (g *ginGroup) document(methods []string, relativePath string, handlers ...gin.HandlerFunc) ginOperations {
+	operations := make(ginOperations, 0, len(methods))
+	for _, method := range methods {
+		tpl, params := generator.PathTemplate(getFullPath(g.prefix, relativePath))
+		gr := &ginRoute{
+			Route: generator.Route{
+				Path:       tpl,
+				Method:     strings.ToUpper(method),
+				FuncName:   getFuncName(handlers...),
+				PathParams: params,
+			},
+		}
+
+		g.routes = append(g.routes, gr)
+		operations = append(operations, gr)
+	}
+
+	return operations
+}
+
 func (g *ginGroup) POST(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	g.gg.POST(relativePath, handlers...)
-	fullPath := getFullPath(g.groupName, relativePath)
+	fullPath := getFullPath(g.prefix, relativePath)
 
+	tpl, params := generator.PathTemplate(fullPath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     fullPath,
-			Method:   http.MethodPost,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodPost,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -207,13 +368,15 @@ func (g *ginGroup) POST(relativePath string, handlers ...gin.HandlerFunc) models
 
 func (g *ginGroup) GET(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	g.gg.GET(relativePath, handlers...)
-	fullPath := getFullPath(g.groupName, relativePath)
+	fullPath := getFullPath(g.prefix, relativePath)
 
+	tpl, params := generator.PathTemplate(fullPath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     fullPath,
-			Method:   http.MethodGet,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodGet,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -224,13 +387,15 @@ func (g *ginGroup) GET(relativePath string, handlers ...gin.HandlerFunc) models.
 
 func (g *ginGroup) PUT(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	g.gg.PUT(relativePath, handlers...)
-	fullPath := getFullPath(g.groupName, relativePath)
+	fullPath := getFullPath(g.prefix, relativePath)
 
+	tpl, params := generator.PathTemplate(fullPath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     fullPath,
-			Method:   http.MethodPut,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodPut,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -241,13 +406,15 @@ func (g *ginGroup) PUT(relativePath string, handlers ...gin.HandlerFunc) models.
 
 func (g *ginGroup) DELETE(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	g.gg.DELETE(relativePath, handlers...)
-	fullPath := getFullPath(g.groupName, relativePath)
+	fullPath := getFullPath(g.prefix, relativePath)
 
+	tpl, params := generator.PathTemplate(fullPath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     fullPath,
-			Method:   http.MethodDelete,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodDelete,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -258,13 +425,15 @@ func (g *ginGroup) DELETE(relativePath string, handlers ...gin.HandlerFunc) mode
 
 func (g *ginGroup) PATCH(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	g.gg.PATCH(relativePath, handlers...)
-	fullPath := getFullPath(g.groupName, relativePath)
+	fullPath := getFullPath(g.prefix, relativePath)
 
+	tpl, params := generator.PathTemplate(fullPath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     fullPath,
-			Method:   http.MethodPatch,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodPatch,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -275,13 +444,15 @@ func (g *ginGroup) PATCH(relativePath string, handlers ...gin.HandlerFunc) model
 
 func (g *ginGroup) OPTIONS(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	g.gg.OPTIONS(relativePath, handlers...)
-	fullPath := getFullPath(g.groupName, relativePath)
+	fullPath := getFullPath(g.prefix, relativePath)
 
+	tpl, params := generator.PathTemplate(fullPath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     fullPath,
-			Method:   http.MethodOptions,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodOptions,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -292,13 +463,15 @@ func (g *ginGroup) OPTIONS(relativePath string, handlers ...gin.HandlerFunc) mod
 
 func (g *ginGroup) HEAD(relativePath string, handlers ...gin.HandlerFunc) models.Swagger {
 	g.gg.HEAD(relativePath, handlers...)
-	fullPath := getFullPath(g.groupName, relativePath)
+	fullPath := getFullPath(g.prefix, relativePath)
 
+	tpl, params := generator.PathTemplate(fullPath)
 	gr := &ginRoute{
 		Route: generator.Route{
-			Path:     fullPath,
-			Method:   http.MethodHead,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     http.MethodHead,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -351,35 +524,46 @@ func (r *ginRoute) Returns(returns []models.ReturnType) models.Swagger {
 	return r
 }
 
-func (r *ginRoute) QueryParam(name, description, paramType string, required bool) models.Swagger {
-	r.Route.QueryParams = append(r.Route.QueryParams, generator.Param{
-		Name:        name,
-		Description: description,
-		ParamType:   paramType,
-		Required:    required,
-	})
+func (r *ginRoute) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.QueryParams = append(r.Route.QueryParams, generator.NewParam(name, description, paramType, required, opts...))
+
+	return r
+}
+
+func (r *ginRoute) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.HeaderParams = append(r.Route.HeaderParams, generator.NewParam(name, description, paramType, required, opts...))
+
+	return r
+}
+
+// PathParam refines the parameter declared from the path variable with the same name.
+func (r *ginRoute) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.PathParams = generator.SetParam(r.Route.PathParams, generator.NewParam(name, description, paramType, required, opts...))
 
 	return r
 }
 
-func (r *ginRoute) HeaderParam(name, description, paramType string, required bool) models.Swagger {
-	r.Route.HeaderParams = append(r.Route.HeaderParams, generator.Param{
-		Name:        name,
-		Description: description,
-		ParamType:   paramType,
-		Required:    required,
-	})
+func (r *ginRoute) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.CookieParams = append(r.Route.CookieParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
 
+func (r *ginRoute) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, paramType, required, opts...))
 	return r
 }
 
-func (r *ginRoute) PathParam(name, description, paramType string, required bool) models.Swagger {
-	r.Route.PathParams = append(r.Route.PathParams, generator.Param{
-		Name:        name,
-		Description: description,
-		ParamType:   paramType,
-		Required:    required,
-	})
+func (r *ginRoute) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, "file", required, opts...))
+	return r
+}
+
+// Params declares the query, header and path parameters read from the tags of the fields of a struct.
+func (r *ginRoute) Params(v any) models.Swagger {
+	path, query, header := generator.StructParams(v, r.Route.Method)
+	r.Route.PathParams = generator.SetParam(r.Route.PathParams, path...)
+	r.Route.QueryParams = generator.SetParam(r.Route.QueryParams, query...)
+	r.Route.HeaderParams = generator.SetParam(r.Route.HeaderParams, header...)
 
 	return r
 }
@@ -388,3 +572,89 @@ func (r *ginRoute) Security(schemes ...string) models.Swagger {
 	r.Route.Security = append(r.Route.Security, schemes...)
 	return r
 }
+
+// ginOperations applies the annotations to the routes of all the methods of a path.
+type ginOperations []*ginRoute
+
+func (ops ginOperations) each(fn func(r *ginRoute)) models.Swagger {
+	for _, r := range ops {
+		fn(r)
+	}
+
+	return ops
+}
+
+// Method returns the route of the method, or a detached one that is not documented.
+func (ops ginOperations) Method(method string) models.Swagger {
+	for _, r := range ops {
+		if strings.EqualFold(r.Route.Method, method) {
+			return r
+		}
+	}
+
+	return &ginRoute{}
+}
+
+func (ops ginOperations) Summary(summary string) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.Summary(summary) })
+}
+
+func (ops ginOperations) Description(description string) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.Description(description) })
+}
+
+func (ops ginOperations) Tags(tags ...string) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.Tags(tags...) })
+}
+
+func (ops ginOperations) Accepts(accepts ...string) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.Accepts(accepts...) })
+}
+
+func (ops ginOperations) Produces(produces ...string) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.Produces(produces...) })
+}
+
+func (ops ginOperations) Read(reads interface{}) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.Read(reads) })
+}
+
+func (ops ginOperations) ReadFieldDescriptions(descriptions map[string]string) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.ReadFieldDescriptions(descriptions) })
+}
+
+func (ops ginOperations) Returns(returns []models.ReturnType) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.Returns(returns) })
+}
+
+func (ops ginOperations) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.QueryParam(name, description, paramType, required, opts...) })
+}
+
+func (ops ginOperations) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.HeaderParam(name, description, paramType, required, opts...) })
+}
+
+func (ops ginOperations) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.PathParam(name, description, paramType, required, opts...) })
+}
+
+func (ops ginOperations) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.CookieParam(name, description, paramType, required, opts...) })
+}
+
+func (ops ginOperations) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.FormParam(name, description, paramType, required, opts...) })
+}
+
+func (ops ginOperations) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.FileParam(name, description, required, opts...) })
+}
+
+func (ops ginOperations) Params(v any) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.Params(v) })
+}
+
+func (ops ginOperations) Security(schemes ...string) models.Swagger {
+	return ops.each(func(r *ginRoute) { r.Security(schemes...) })
+}
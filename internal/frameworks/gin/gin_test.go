@@ -1,6 +1,9 @@
 package gin
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -58,9 +61,10 @@ func TestGinSwagger_Handle(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "GET",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "GET",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -93,9 +97,10 @@ func TestGinSwagger_POST(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "POST",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "POST",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -128,9 +133,10 @@ func TestGinSwagger_GET(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "GET",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "GET",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -163,9 +169,10 @@ func TestGinSwagger_PUT(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "PUT",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "PUT",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -198,9 +205,10 @@ func TestGinSwagger_DELETE(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "DELETE",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "DELETE",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -233,9 +241,10 @@ func TestGinSwagger_PATCH(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "PATCH",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "PATCH",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -268,9 +277,10 @@ func TestGinSwagger_OPTIONS(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "OPTIONS",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "OPTIONS",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -303,9 +313,10 @@ func TestGinSwagger_HEAD(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "HEAD",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "HEAD",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -320,6 +331,38 @@ func TestGinSwagger_HEAD(t *testing.T) {
 	}
 }
 
+func TestGinGroup_Group(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s := NewGin(gin.New())
+
+	var calls []string
+	middleware := func(name string) gin.HandlerFunc {
+		return func(c *gin.Context) { calls = append(calls, name) }
+	}
+
+	users := s.Group("/api", middleware("api")).Group("/v1", middleware("v1")).Group("/users")
+	users.GET("/:id", func(c *gin.Context) { calls = append(calls, "handler") })
+
+	assert.Equal(t, []generator.Group{
+		{
+			GroupName: "/api",
+			Groups: []generator.Group{
+				{
+					GroupName: "/v1",
+					Groups: []generator.Group{
+						{GroupName: "/users", Routes: []generator.Route{{Path: "/api/v1/users/{id}", Method: http.MethodGet, FuncName: "func2", PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}}}}},
+					},
+				},
+			},
+		},
+	}, toGoSwagGroup(s.groups))
+
+	w := httptest.NewRecorder()
+	s.Gin().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/users/1", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"api", "v1", "handler"}, calls)
+}
+
 func TestGinGroup_Handle(t *testing.T) {
 	type args struct {
 		httpMethod   string
@@ -340,9 +383,10 @@ func TestGinGroup_Handle(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "GET",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "GET",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -376,9 +420,10 @@ func TestGinGroup_POST(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "POST",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "POST",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -412,9 +457,10 @@ func TestGinGroup_GET(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "GET",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "GET",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -448,9 +494,10 @@ func TestGinGroup_PUT(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "PUT",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "PUT",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -484,9 +531,10 @@ func TestGinGroup_DELETE(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "DELETE",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "DELETE",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -520,9 +568,10 @@ func TestGinGroup_PATCH(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "PATCH",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "PATCH",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -556,9 +605,10 @@ func TestGinGroup_OPTIONS(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "OPTIONS",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "OPTIONS",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -592,9 +642,10 @@ func TestGinGroup_HEAD(t *testing.T) {
 				handlers:     []gin.HandlerFunc{func(c *gin.Context) {}},
 			},
 			want: generator.Route{
-				Path:     "/test/:id/",
-				Method:   "HEAD",
-				FuncName: "func1",
+				Path:       "/test/{id}/",
+				Method:     "HEAD",
+				FuncName:   "func1",
+				PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
 			},
 		},
 	}
@@ -733,3 +784,152 @@ func TestGinRoute_PathParam(t *testing.T) {
 		assert.Equal(t, []generator.Param{{Name: "test", Description: "test", ParamType: "test", Required: true}}, g.Route.PathParams)
 	})
 }
+
+func TestGinSwagger_ServeDocs(t *testing.T) {
+	for prefix, base := range map[string]string{"/docs": "/docs", "/docs/": "/docs", "/": ""} {
+		s := NewGin(gin.New())
+		s.ServeDocs(prefix)
+		s.Group("/users").GET("/:id", func(c *gin.Context) {}).Summary("Get user")
+
+		for path, contains := range map[string]string{
+			base + "/":             "swagger-ui",
+			base + "/redoc":        "redoc",
+			base + "/openapi.json": `"/users/{id}"`,
+			base + "/openapi.yaml": "summary: Get user",
+			"/users/1":             "",
+		} {
+			w := httptest.NewRecorder()
+			s.Gin().ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+			assert.Equal(t, http.StatusOK, w.Code, prefix+" "+path)
+			assert.Contains(t, w.Body.String(), contains, prefix+" "+path)
+		}
+	}
+}
+
+func TestGinSwagger_ValidationMiddleware(t *testing.T) {
+	type body struct {
+		Name string `json:"name" binding:"required"`
+	}
+
+	s := NewGin(gin.New())
+	s.Gin().Use(s.ValidationMiddleware(models.ValidationOptions{Status: http.StatusUnprocessableEntity}))
+	s.Group("/users").POST("/:id", func(c *gin.Context) { c.Status(http.StatusCreated) }).
+		PathParam("id", "user id", "int", true).
+		Read(body{})
+
+	for request, want := range map[[2]string]int{
+		{"/users/1", `{"name":"john"}`}: http.StatusCreated,
+		{"/users/a", `{"name":"john"}`}: http.StatusUnprocessableEntity,
+		{"/users/1", `{}`}:              http.StatusUnprocessableEntity,
+		{"/users/1", ``}:                http.StatusUnprocessableEntity,
+	} {
+		w := httptest.NewRecorder()
+		s.Gin().ServeHTTP(w, httptest.NewRequest(http.MethodPost, request[0], strings.NewReader(request[1])))
+
+		assert.Equal(t, want, w.Code, request)
+	}
+}
+
+func handleFile(c *gin.Context) {
+	c.String(http.StatusOK, c.Param("id"))
+}
+
+func TestGinSwagger_AnyMatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s := NewGin(gin.New())
+
+	files := s.Group("/files")
+	m := files.Match([]string{http.MethodGet, http.MethodHead}, "/:id", handleFile)
+	m.Summary("Get file").Tags("files")
+	m.Method(http.MethodHead).Summary("Check file")
+	m.Method(http.MethodPost).Summary("not documented")
+	s.Any("/echo", handleFile).Summary("Echo")
+
+	assert.Len(t, s.routes, len(generator.AnyMethods))
+	for i, r := range s.routes {
+		assert.Equal(t, generator.Route{Path: "/echo", Method: generator.AnyMethods[i], FuncName: "handleFile", Summary: "Echo"}, r.Route)
+	}
+
+	idParam := generator.Param{Name: "id", ParamType: "string", Required: true}
+	assert.Equal(t, []generator.Route{
+		{Path: "/files/{id}", Method: http.MethodGet, FuncName: "handleFile", Summary: "Get file", Tags: []string{"files"}, PathParams: []generator.Param{idParam}},
+		{Path: "/files/{id}", Method: http.MethodHead, FuncName: "handleFile", Summary: "Check file", Tags: []string{"files"}, PathParams: []generator.Param{idParam}},
+	}, toGoSwagRoute(s.groups[0].routes))
+
+	for method, want := range map[string]int{http.MethodGet: http.StatusOK, http.MethodPost: http.StatusNotFound} {
+		w := httptest.NewRecorder()
+		s.Gin().ServeHTTP(w, httptest.NewRequest(method, "/files/42", nil))
+		assert.Equal(t, want, w.Code, method)
+	}
+
+	w := httptest.NewRecorder()
+	s.Gin().ServeHTTP(w, httptest.NewRequest(http.MethodTrace, "/echo", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGinSwagger_PathParams(t *testing.T) {
+	s := NewGin(gin.New())
+
+	s.GET("/users/:id/files/*path", handleFile).PathParam("id", "user id", "int", true)
+
+	assert.Equal(t, "/users/{id}/files/{path}", s.routes[0].Route.Path)
+	assert.Equal(t, []generator.Param{
+		{Name: "id", Description: "user id", ParamType: "int", Required: true},
+		{Name: "path", ParamType: "string", Required: true},
+	}, s.routes[0].Route.PathParams)
+}
+
+func TestGinSwagger_Params(t *testing.T) {
+	type fileParams struct {
+		ID      int    `uri:"id" doc:"user id"`
+		Version string `form:"version,default=latest"`
+		Tenant  string `header:"X-Tenant" required:"true"`
+	}
+
+	s := NewGin(gin.New())
+
+	s.GET("/users/:id/files/*path", handleFile).QueryParam("version", "old", "int", false).Params(fileParams{})
+
+	route := s.routes[0].Route
+	assert.Equal(t, []generator.Param{
+		{Name: "id", Description: "user id", ParamType: "int", Required: true},
+		{Name: "path", ParamType: "string", Required: true},
+	}, route.PathParams)
+	assert.Equal(t, []generator.Param{{Name: "version", ParamType: "string", Default: "latest"}}, route.QueryParams)
+	assert.Equal(t, []generator.Param{{Name: "X-Tenant", ParamType: "string", Required: true}}, route.HeaderParams)
+}
+
+func TestGinSwagger_ParamsWithBody(t *testing.T) {
+	type updateUser struct {
+		ID   int    `uri:"id"`
+		Name string `form:"name" json:"name"`
+	}
+
+	s := NewGin(gin.New())
+
+	s.Match([]string{http.MethodGet, http.MethodPut}, "/users/:id", handleFile).Params(updateUser{})
+
+	get, put := s.routes[0].Route, s.routes[1].Route
+	assert.Equal(t, []generator.Param{{Name: "name", ParamType: "string"}}, get.QueryParams)
+	assert.Empty(t, put.QueryParams, "the form fields of a PUT route are read from its body")
+	assert.Equal(t, []generator.Param{{Name: "id", ParamType: "int", Required: true}}, put.PathParams)
+}
+
+func TestGinSwagger_FormParams(t *testing.T) {
+	s := NewGin(gin.New())
+
+	s.Match([]string{http.MethodPost, http.MethodPut}, "/avatar", handleFile).
+		CookieParam("session", "session id", "string", true).
+		FormParam("caption", "avatar caption", "string", false).
+		FileParam("avatar", "user avatar", true, func(o *models.ParamOptions) { o.MimeTypes = []string{"image/png"} })
+
+	assert.Len(t, s.routes, 2)
+	for _, r := range s.routes {
+		assert.Equal(t, []generator.Param{{Name: "session", Description: "session id", ParamType: "string", Required: true}}, r.Route.CookieParams)
+		assert.Equal(t, []generator.Param{
+			{Name: "caption", Description: "avatar caption", ParamType: "string"},
+			{Name: "avatar", Description: "user avatar", ParamType: "file", Required: true, MimeTypes: []string{"image/png"}},
+		}, r.Route.FormParams)
+	}
+}
@@ -46,6 +46,7 @@ func toGoSwagGroup(from []*ginGroup) []generator.Group {
 		groups = append(groups, generator.Group{
 			GroupName: g.groupName,
 			Routes:    toGoSwagRoute(g.routes),
+			Groups:    toGoSwagGroup(g.groups),
 		})
 	}
 
@@ -0,0 +1,60 @@
+package gin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/r0bertson/goswag/internal/typed"
+	"github.com/r0bertson/goswag/models"
+)
+
+// Handle registers the typed handler for the method. The request is bound from the json body for POST,
+// PUT and PATCH and from the query for the other methods, its fields with a uri, path or param tag from
+// the path, and the response is written as json.
+// The errors are added to the context with c.Error and answered as {"message": "..."}.
+func Handle[Req, Resp any](router models.GinRouter, method, path string, h func(context.Context, Req) (Resp, error)) models.Swagger {
+	route := router.Handle(method, path, func(c *gin.Context) {
+		var req Req
+		if !typed.IsEmpty(req) {
+			bind := c.ShouldBindQuery
+			if typed.HasBody(method) {
+				bind = c.ShouldBindJSON
+			}
+
+			// the path is bound first, so the binding tags are validated with the whole request
+			err := typed.BindPath(&req, c.Param)
+			if err == nil {
+				err = bind(&req)
+			}
+
+			if err != nil {
+				_ = c.Error(err)
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+				return
+			}
+		}
+
+		resp, err := h(c.Request.Context(), req)
+		if err != nil {
+			_ = c.Error(err)
+			status, message := typed.ErrorResponse(err)
+			c.AbortWithStatusJSON(status, gin.H{"message": message})
+			return
+		}
+
+		if typed.IsEmpty(resp) {
+			c.Status(typed.Status(method))
+			return
+		}
+
+		c.JSON(typed.Status(method), resp)
+	})
+
+	if r, ok := route.(*ginRoute); ok {
+		r.Route.FuncName = typed.FuncName(h)
+		r.Route.Success = typed.Success[Resp](method)
+	}
+
+	return typed.Annotate[Req](route, method)
+}
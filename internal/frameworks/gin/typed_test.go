@@ -0,0 +1,122 @@
+package gin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type createUser struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type user struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func createUserHandler(ctx context.Context, req createUser) (user, error) {
+	if req.Name == "taken" {
+		return user{}, &models.StatusError{Status: http.StatusConflict, Err: errors.New("name is taken")}
+	}
+
+	return user{ID: "1", Name: req.Name}, nil
+}
+
+type updateUser struct {
+	ID   string `uri:"id" binding:"required"`
+	Name string `json:"name" binding:"required"`
+}
+
+func updateUserHandler(ctx context.Context, req updateUser) (user, error) {
+	return user{ID: req.ID, Name: req.Name}, nil
+}
+
+func deleteUserHandler(ctx context.Context, req struct{}) (struct{}, error) {
+	return struct{}{}, errors.New("database is down")
+}
+
+func TestHandle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s := NewGin(gin.New())
+	users := s.Group("/users")
+	Handle(users, http.MethodPost, "", createUserHandler).Summary("Create user")
+	Handle(users, http.MethodDelete, "/:id", deleteUserHandler)
+
+	route := s.groups[0].routes[0].Route
+	assert.Equal(t, "createUserHandler", route.FuncName)
+	assert.Equal(t, "Create user", route.Summary)
+	assert.Equal(t, createUser{}, route.Reads)
+	assert.Equal(t, &models.ReturnType{StatusCode: http.StatusCreated, Body: user{}}, route.Success)
+
+	route = s.groups[0].routes[1].Route
+	assert.Nil(t, route.Reads)
+	assert.Equal(t, &models.ReturnType{StatusCode: http.StatusOK}, route.Success)
+
+	tests := []struct {
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{method: http.MethodPost, path: "/users", body: `{"name":"Ada"}`, wantStatus: http.StatusCreated, wantBody: `{"id":"1","name":"Ada"}`},
+		{method: http.MethodPost, path: "/users", body: `{"name":"taken"}`, wantStatus: http.StatusConflict, wantBody: `{"message":"name is taken"}`},
+		{method: http.MethodPost, path: "/users", body: `{}`, wantStatus: http.StatusBadRequest},
+		{method: http.MethodDelete, path: "/users/1", wantStatus: http.StatusInternalServerError, wantBody: `{"message":"Internal Server Error"}`},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		s.Gin().ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+
+		assert.Equal(t, tt.wantStatus, w.Code, tt.body)
+		if tt.wantBody != "" {
+			assert.JSONEq(t, tt.wantBody, w.Body.String(), tt.body)
+		}
+	}
+}
+
+func TestHandle_pathParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s := NewGin(gin.New())
+	Handle(s.Group("/users"), http.MethodPut, "/:id", updateUserHandler)
+
+	for body, want := range map[string]int{`{"name":"Ada"}`: http.StatusOK, `{}`: http.StatusBadRequest} {
+		w := httptest.NewRecorder()
+		s.Gin().ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/users/7", strings.NewReader(body)))
+
+		assert.Equal(t, want, w.Code, body)
+		if want == http.StatusOK {
+			assert.JSONEq(t, `{"id":"7","name":"Ada"}`, w.Body.String())
+		}
+	}
+}
+
+func TestHandle_returns(t *testing.T) {
+	s := NewGin(gin.New())
+	users := s.Group("/users")
+	Handle(users, http.MethodPut, "/:id", updateUserHandler).
+		Returns([]models.ReturnType{{StatusCode: http.StatusNotFound}})
+	Handle(users, http.MethodPost, "", createUserHandler).
+		Returns([]models.ReturnType{{StatusCode: http.StatusAccepted}})
+
+	doc := generator.BuildOpenAPI(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), nil, nil)
+
+	// the inferred success response is kept next to the declared ones, unless they declare their own
+	put := doc.Paths["/users/{id}"]["put"].Responses
+	assert.Contains(t, put, "200")
+	assert.Contains(t, put, "404")
+
+	post := doc.Paths["/users"]["post"].Responses
+	assert.Contains(t, post, "202")
+	assert.NotContains(t, post, "201")
+}
@@ -0,0 +1,376 @@
+package gorilla
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/r0bertson/goswag/internal/docs"
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/internal/validator"
+	"github.com/r0bertson/goswag/models"
+)
+
+type gorillaSwagger struct {
+	*gorillaRouter
+	defaultResponses []models.ReturnType
+	info             *models.Info
+}
+
+func NewGorilla(r *mux.Router, defaultResponses ...models.ReturnType) *gorillaSwagger {
+	return &gorillaSwagger{
+		gorillaRouter:    &gorillaRouter{r: r},
+		defaultResponses: defaultResponses,
+	}
+}
+
+func (s *gorillaSwagger) Router() *mux.Router {
+	return s.r
+}
+
+// ServeHTTP serves the requests with the gorilla router, so the wrapper can be served directly.
+func (s *gorillaSwagger) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.r.ServeHTTP(w, r)
+}
+
+// SetInfo sets the general information of the API written in the generated documentation.
+func (s *gorillaSwagger) SetInfo(info models.Info) {
+	s.info = &info
+}
+
+func (s *gorillaSwagger) GenerateSwagger() {
+	generator.GenerateSwagger(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
+}
+
+func (s *gorillaSwagger) GenerateSwaggerE(opts models.GenerateOptions) error {
+	if opts.Info == nil {
+		opts.Info = s.info
+	}
+
+	return generator.Generate(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, opts)
+}
+
+func (s *gorillaSwagger) GenerateOpenAPI() {
+	generator.GenerateOpenAPI(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
+}
+
+// ServeDocs mounts the Swagger UI, Redoc and the OpenAPI document of the routes under the prefix.
+// The docs routes are registered directly in the router, so they are not documented.
+func (s *gorillaSwagger) ServeDocs(prefix string) {
+	h := docs.Handler(prefix, s.OpenAPI)
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		// the routes are matched in order, a prefix route at the root would hide the next ones
+		for _, path := range docs.Paths {
+			s.r.Handle(path, h).Methods(http.MethodGet)
+		}
+
+		return
+	}
+
+	s.r.Handle(prefix, h).Methods(http.MethodGet)
+	s.r.PathPrefix(prefix + "/").Handler(h).Methods(http.MethodGet)
+}
+
+// ValidationMiddleware returns a middleware that rejects the requests that do not match the
+// documentation of their route. It must be registered with Use, so the matched route is known.
+func (s *gorillaSwagger) ValidationMiddleware(opts ...models.ValidationOptions) func(http.Handler) http.Handler {
+	registry := validator.NewRegistry(func() ([]generator.Route, []generator.Group) {
+		return toGoSwagRoute(s.routes), toGoSwagGroup(s.groups)
+	}, opts...)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := mux.CurrentRoute(r)
+			if route == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			tpl, err := route.GetPathTemplate()
+			path, _ := toSwagPath(tpl)
+			vars := mux.Vars(r)
+
+			if err != nil || registry.Check(w, r, path, func(name string) string { return vars[name] }) {
+				next.ServeHTTP(w, r)
+			}
+		})
+	}
+}
+
+// OpenAPI returns the json of the OpenAPI 3.1 document of the routes registered so far.
+func (s *gorillaSwagger) OpenAPI() ([]byte, error) {
+	return generator.MarshalOpenAPI(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
+}
+
+type gorillaRouter struct {
+	r         *mux.Router
+	prefix    string
+	groupName string
+	groups    []*gorillaRouter
+	routes    []*gorillaOperation
+}
+
+func (c *gorillaRouter) Use(middlewares ...mux.MiddlewareFunc) {
+	c.r.Use(middlewares...)
+}
+
+func (c *gorillaRouter) Handle(path string, h http.Handler) models.GorillaRoute {
+	return &gorillaRoute{
+		route:    c.r.Handle(path, h),
+		router:   c,
+		path:     c.prefix + path,
+		funcName: getFuncName(h),
+	}
+}
+
+func (c *gorillaRouter) HandleFunc(path string, f func(http.ResponseWriter, *http.Request)) models.GorillaRoute {
+	return c.Handle(path, http.HandlerFunc(f))
+}
+
+// Any registers the route for every method, the methods of generator.AnyMethods are documented.
+func (c *gorillaRouter) Any(path string, f func(http.ResponseWriter, *http.Request)) models.MultiSwagger {
+	r := c.HandleFunc(path, f).(*gorillaRoute)
+	return r.document(generator.AnyMethods)
+}
+
+func (c *gorillaRouter) Match(methods []string, path string, f func(http.ResponseWriter, *http.Request)) models.MultiSwagger {
+	return c.HandleFunc(path, f).Methods(methods...)
+}
+
+func (c *gorillaRouter) PathPrefix(tpl string) models.GorillaPrefix {
+	return &gorillaPrefix{route: c.r.PathPrefix(tpl), router: c, tpl: tpl}
+}
+
+// gorillaRoute is a route registered without methods, it is documented by Methods.
+type gorillaRoute struct {
+	route    *mux.Route
+	router   *gorillaRouter
+	path     string
+	funcName string
+}
+
+func (r *gorillaRoute) Methods(methods ...string) models.MultiSwagger {
+	r.route.Methods(methods...)
+	return r.document(methods)
+}
+
+// document adds one operation per method to the router.
+func (r *gorillaRoute) document(methods []string) gorillaOperations {
+	path, params := toSwagPath(r.path)
+
+	operations := make(gorillaOperations, 0, len(methods))
+	for _, method := range methods {
+		op := &gorillaOperation{
+			Route: generator.Route{
+				Path:       path,
+				Method:     strings.ToUpper(method),
+				FuncName:   r.funcName,
+				PathParams: append([]generator.Param(nil), params...),
+			},
+		}
+
+		r.router.routes = append(r.router.routes, op)
+		operations = append(operations, op)
+	}
+
+	return operations
+}
+
+type gorillaPrefix struct {
+	route  *mux.Route
+	router *gorillaRouter
+	tpl    string
+}
+
+func (p *gorillaPrefix) Subrouter() models.GorillaRouter {
+	sub := &gorillaRouter{r: p.route.Subrouter(), prefix: p.router.prefix + p.tpl, groupName: p.tpl}
+	p.router.groups = append(p.router.groups, sub)
+
+	return sub
+}
+
+func (p *gorillaPrefix) Handler(h http.Handler) {
+	p.route.Handler(h)
+}
+
+func (p *gorillaPrefix) HandlerFunc(f func(http.ResponseWriter, *http.Request)) {
+	p.route.HandlerFunc(f)
+}
+
+// gorillaOperations applies the annotations to the operations of all the methods of a route.
+type gorillaOperations []*gorillaOperation
+
+func (ops gorillaOperations) each(fn func(op *gorillaOperation)) models.Swagger {
+	for _, op := range ops {
+		fn(op)
+	}
+
+	return ops
+}
+
+// Method returns the operation of the method, or a detached one that is not documented.
+func (ops gorillaOperations) Method(method string) models.Swagger {
+	for _, op := range ops {
+		if strings.EqualFold(op.Route.Method, method) {
+			return op
+		}
+	}
+
+	return &gorillaOperation{}
+}
+
+func (ops gorillaOperations) Summary(summary string) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.Summary(summary) })
+}
+
+func (ops gorillaOperations) Description(description string) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.Description(description) })
+}
+
+func (ops gorillaOperations) Tags(tags ...string) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.Tags(tags...) })
+}
+
+func (ops gorillaOperations) Accepts(accepts ...string) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.Accepts(accepts...) })
+}
+
+func (ops gorillaOperations) Produces(produces ...string) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.Produces(produces...) })
+}
+
+func (ops gorillaOperations) Read(reads interface{}) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.Read(reads) })
+}
+
+func (ops gorillaOperations) ReadFieldDescriptions(descriptions map[string]string) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.ReadFieldDescriptions(descriptions) })
+}
+
+func (ops gorillaOperations) Returns(returns []models.ReturnType) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.Returns(returns) })
+}
+
+func (ops gorillaOperations) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.QueryParam(name, description, paramType, required, opts...) })
+}
+
+func (ops gorillaOperations) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.HeaderParam(name, description, paramType, required, opts...) })
+}
+
+func (ops gorillaOperations) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.PathParam(name, description, paramType, required, opts...) })
+}
+
+func (ops gorillaOperations) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.CookieParam(name, description, paramType, required, opts...) })
+}
+
+func (ops gorillaOperations) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.FormParam(name, description, paramType, required, opts...) })
+}
+
+func (ops gorillaOperations) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.FileParam(name, description, required, opts...) })
+}
+
+func (ops gorillaOperations) Params(v any) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.Params(v) })
+}
+
+func (ops gorillaOperations) Security(schemes ...string) models.Swagger {
+	return ops.each(func(op *gorillaOperation) { op.Security(schemes...) })
+}
+
+type gorillaOperation struct {
+	Route generator.Route
+}
+
+func (r *gorillaOperation) Summary(summary string) models.Swagger {
+	r.Route.Summary = summary
+	return r
+}
+
+func (r *gorillaOperation) Description(description string) models.Swagger {
+	r.Route.Description = description
+	return r
+}
+
+func (r *gorillaOperation) Tags(tags ...string) models.Swagger {
+	r.Route.Tags = tags
+	return r
+}
+
+func (r *gorillaOperation) Accepts(accepts ...string) models.Swagger {
+	r.Route.Accepts = accepts
+	return r
+}
+
+func (r *gorillaOperation) Produces(produces ...string) models.Swagger {
+	r.Route.Produces = produces
+	return r
+}
+
+func (r *gorillaOperation) Read(reads interface{}) models.Swagger {
+	r.Route.Reads = reads
+	return r
+}
+
+func (r *gorillaOperation) ReadFieldDescriptions(descriptions map[string]string) models.Swagger {
+	r.Route.ReadFieldDescriptions = descriptions
+	return r
+}
+
+func (r *gorillaOperation) Returns(returns []models.ReturnType) models.Swagger {
+	r.Route.Returns = returns
+	return r
+}
+
+func (r *gorillaOperation) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.QueryParams = append(r.Route.QueryParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *gorillaOperation) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.HeaderParams = append(r.Route.HeaderParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+// PathParam refines the parameter declared from the path variable with the same name,
+// the pattern of the variable is kept.
+func (r *gorillaOperation) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.PathParams = generator.SetParam(r.Route.PathParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *gorillaOperation) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.CookieParams = append(r.Route.CookieParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *gorillaOperation) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *gorillaOperation) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, "file", required, opts...))
+	return r
+}
+
+// Params declares the query, header and path parameters read from the tags of the fields of a struct.
+func (r *gorillaOperation) Params(v any) models.Swagger {
+	path, query, header := generator.StructParams(v, r.Route.Method)
+	r.Route.PathParams = generator.SetParam(r.Route.PathParams, path...)
+	r.Route.QueryParams = generator.SetParam(r.Route.QueryParams, query...)
+	r.Route.HeaderParams = generator.SetParam(r.Route.HeaderParams, header...)
+
+	return r
+}
+
+func (r *gorillaOperation) Security(schemes ...string) models.Swagger {
+	r.Route.Security = append(r.Route.Security, schemes...)
+	return r
+}
@@ -0,0 +1,252 @@
+package gorilla
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func handleGetUser(w http.ResponseWriter, r *http.Request) {
+	_, _ = w.Write([]byte(mux.Vars(r)["id"]))
+}
+
+type statusHandler struct{}
+
+func (statusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {}
+
+func TestNewGorilla(t *testing.T) {
+	r := mux.NewRouter()
+	s := NewGorilla(r)
+
+	assert.Equal(t, r, s.Router())
+}
+
+func TestGorillaSwagger_routes(t *testing.T) {
+	s := NewGorilla(mux.NewRouter())
+
+	s.HandleFunc("/health", handleGetUser).Methods(http.MethodGet)
+	s.HandleFunc("/undocumented", handleGetUser)
+	s.PathPrefix("/static/").Handler(http.NotFoundHandler())
+
+	v1 := s.PathPrefix("/v1").Subrouter()
+	v1.Handle("/status", statusHandler{}).Methods("patch")
+
+	users := v1.PathPrefix("/users").Subrouter()
+	users.HandleFunc("/{id:[0-9]+}", handleGetUser).Methods(http.MethodGet, http.MethodPut).
+		Summary("Get or replace user").
+		PathParam("id", "user id", "int", true)
+
+	idParam := generator.Param{Name: "id", Description: "user id", ParamType: "int", Required: true, Pattern: "^[0-9]+$"}
+
+	assert.Equal(t, []generator.Route{{Path: "/health", Method: http.MethodGet, FuncName: "handleGetUser"}}, toGoSwagRoute(s.routes))
+	assert.Equal(t, []generator.Group{
+		{
+			GroupName: "/v1",
+			Routes:    []generator.Route{{Path: "/v1/status", Method: http.MethodPatch, FuncName: "statusHandler"}},
+			Groups: []generator.Group{
+				{
+					GroupName: "/users",
+					Routes: []generator.Route{
+						{Path: "/v1/users/{id}", Method: http.MethodGet, FuncName: "handleGetUser", Summary: "Get or replace user", PathParams: []generator.Param{idParam}},
+						{Path: "/v1/users/{id}", Method: http.MethodPut, FuncName: "handleGetUser", Summary: "Get or replace user", PathParams: []generator.Param{idParam}},
+					},
+				},
+			},
+		},
+	}, toGoSwagGroup(s.groups))
+
+	for _, method := range []string{http.MethodGet, http.MethodPut} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(method, "/v1/users/42", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code, method)
+		assert.Equal(t, "42", w.Body.String(), method)
+	}
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/v1/users/42", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestToSwagPath(t *testing.T) {
+	tests := []struct {
+		tpl        string
+		wantPath   string
+		wantParams []generator.Param
+	}{
+		{tpl: "/users", wantPath: "/users"},
+		{
+			tpl:        "/users/{id}",
+			wantPath:   "/users/{id}",
+			wantParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}},
+		},
+		{
+			tpl:      "/users/{id:[0-9]+}/posts/{slug:[a-z]{2,8}}",
+			wantPath: "/users/{id}/posts/{slug}",
+			wantParams: []generator.Param{
+				{Name: "id", ParamType: "string", Required: true, Pattern: "^[0-9]+$"},
+				{Name: "slug", ParamType: "string", Required: true, Pattern: "^[a-z]{2,8}$"},
+			},
+		},
+		{
+			tpl:        "/files/{kind:img|doc}",
+			wantPath:   "/files/{kind}",
+			wantParams: []generator.Param{{Name: "kind", ParamType: "string", Required: true, Pattern: "^(?:img|doc)$"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tpl, func(t *testing.T) {
+			path, params := toSwagPath(tt.tpl)
+			assert.Equal(t, tt.wantPath, path)
+			assert.Equal(t, tt.wantParams, params)
+		})
+	}
+}
+
+func TestGorillaSwagger_OpenAPI(t *testing.T) {
+	s := NewGorilla(mux.NewRouter())
+	s.PathPrefix("/users").Subrouter().HandleFunc("/{id:[0-9]+}", handleGetUser).Methods(http.MethodGet)
+
+	content, err := s.OpenAPI()
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), `"/users/{id}"`)
+	assert.Contains(t, string(content), `"pattern": "^[0-9]+$"`)
+}
+
+func TestGorillaSwagger_ServeDocs(t *testing.T) {
+	s := NewGorilla(mux.NewRouter())
+	s.ServeDocs("/docs")
+	s.HandleFunc("/users/{id}", handleGetUser).Methods(http.MethodGet).Summary("Get user")
+
+	for path, contains := range map[string]string{
+		"/docs":              "swagger-ui",
+		"/docs/redoc":        "redoc",
+		"/docs/openapi.json": `"/users/{id}"`,
+		"/docs/openapi.yaml": "summary: Get user",
+	} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code, path)
+		assert.Contains(t, w.Body.String(), contains, path)
+	}
+}
+
+func TestGorillaSwagger_ServeDocsRoot(t *testing.T) {
+	s := NewGorilla(mux.NewRouter())
+	s.ServeDocs("/")
+	s.HandleFunc("/users/{id}", handleGetUser).Methods(http.MethodGet)
+
+	// the other routes are still reachable next to the docs served at the root
+	for path, contains := range map[string]string{
+		"/":             "swagger-ui",
+		"/redoc":        "redoc",
+		"/openapi.json": `"/users/{id}"`,
+		"/users/1":      "",
+	} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code, path)
+		assert.Contains(t, w.Body.String(), contains, path)
+	}
+}
+
+func TestGorillaSwagger_ValidationMiddleware(t *testing.T) {
+	s := NewGorilla(mux.NewRouter())
+	s.Use(s.ValidationMiddleware())
+	s.PathPrefix("/users").Subrouter().HandleFunc("/{id:[a-z0-9]+}", handleGetUser).Methods(http.MethodGet).
+		PathParam("id", "user id", "int", true).
+		QueryParam("verbose", "verbose output", "bool", false)
+
+	for path, want := range map[string]int{
+		"/users/1":             http.StatusOK,
+		"/users/a":             http.StatusBadRequest,
+		"/users/1?verbose=yes": http.StatusBadRequest,
+		"/items/1":             http.StatusNotFound,
+	} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, want, w.Code, path)
+	}
+}
+
+func TestGorillaOperations(t *testing.T) {
+	ops := gorillaOperations{
+		{Route: generator.Route{Method: http.MethodGet, PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true, Pattern: "^[0-9]+$"}}}},
+		{Route: generator.Route{Method: http.MethodPost}},
+	}
+	ops.Summary("summary").
+		Description("description").
+		Tags("users").
+		Accepts("json").
+		Produces("xml").
+		Read(struct{}{}).
+		ReadFieldDescriptions(map[string]string{"name": "Name"}).
+		Returns([]models.ReturnType{{StatusCode: http.StatusOK}}).
+		QueryParam("q", "query", "string", false).
+		HeaderParam("h", "header", "string", true).
+		PathParam("id", "id", "int", true).
+		Security("BearerAuth")
+
+	for _, op := range ops {
+		assert.Equal(t, generator.Route{
+			Method:                op.Route.Method,
+			Summary:               "summary",
+			Description:           "description",
+			Tags:                  []string{"users"},
+			Accepts:               []string{"json"},
+			Produces:              []string{"xml"},
+			Reads:                 struct{}{},
+			ReadFieldDescriptions: map[string]string{"name": "Name"},
+			Returns:               []models.ReturnType{{StatusCode: http.StatusOK}},
+			QueryParams:           []generator.Param{{Name: "q", Description: "query", ParamType: "string"}},
+			HeaderParams:          []generator.Param{{Name: "h", Description: "header", ParamType: "string", Required: true}},
+			PathParams:            []generator.Param{{Name: "id", Description: "id", ParamType: "int", Required: true, Pattern: op.Route.PathParams[0].Pattern}},
+			Security:              []string{"BearerAuth"},
+		}, op.Route)
+	}
+
+	assert.Equal(t, "^[0-9]+$", ops[0].Route.PathParams[0].Pattern)
+	assert.Empty(t, ops[1].Route.PathParams[0].Pattern)
+}
+
+func TestGorillaSwagger_AnyMatch(t *testing.T) {
+	s := NewGorilla(mux.NewRouter())
+
+	files := s.PathPrefix("/files").Subrouter()
+	m := files.Match([]string{"get", http.MethodHead}, "/{id}", handleGetUser)
+	m.Summary("Get file").Tags("files")
+	m.Method(http.MethodHead).Summary("Check file")
+	m.Method(http.MethodPost).Summary("not documented")
+	s.Any("/echo", handleGetUser).Summary("Echo")
+
+	routes := toGoSwagRoute(s.routes)
+	assert.Len(t, routes, len(generator.AnyMethods))
+	for i, r := range routes {
+		assert.Equal(t, generator.Route{Path: "/echo", Method: generator.AnyMethods[i], FuncName: "handleGetUser", Summary: "Echo"}, r)
+	}
+
+	idParam := generator.Param{Name: "id", ParamType: "string", Required: true}
+	assert.Equal(t, []generator.Route{
+		{Path: "/files/{id}", Method: http.MethodGet, FuncName: "handleGetUser", Summary: "Get file", Tags: []string{"files"}, PathParams: []generator.Param{idParam}},
+		{Path: "/files/{id}", Method: http.MethodHead, FuncName: "handleGetUser", Summary: "Check file", Tags: []string{"files"}, PathParams: []generator.Param{idParam}},
+	}, toGoSwagGroup(s.groups)[0].Routes)
+
+	for method, want := range map[string]int{http.MethodGet: http.StatusOK, http.MethodPost: http.StatusMethodNotAllowed} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(method, "/files/42", nil))
+		assert.Equal(t, want, w.Code, method)
+	}
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest(http.MethodTrace, "/echo", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
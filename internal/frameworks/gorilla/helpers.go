@@ -0,0 +1,107 @@
+package gorilla
+
+import (
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/r0bertson/goswag/internal/generator"
+)
+
+// getFuncName retrieves the name of the function of the handler.
+// It uses the reflect package to obtain the function name from the pointer value of the handler.
+// The function name is extracted by splitting the full function name string using the dot separator and returning the last element.
+// Handlers that are not functions are named after their type.
+func getFuncName(h http.Handler) string {
+	var fullFuncName string
+	if hf, ok := h.(http.HandlerFunc); ok {
+		fullFuncName = runtime.FuncForPC(reflect.ValueOf(hf).Pointer()).Name()
+	} else {
+		fullFuncName = reflect.Indirect(reflect.ValueOf(h)).Type().Name()
+	}
+
+	funcNameSplit := strings.Split(fullFuncName, ".")
+	funcName := funcNameSplit[len(funcNameSplit)-1]
+	funcName = strings.TrimSuffix(funcName, "-fm")
+
+	return funcName
+}
+
+// toSwagPath translates a gorilla path template to a path template without the regular expressions
+// of the variables, e.g. /users/{id:[0-9]+} becomes /users/{id}. Every variable is returned as a
+// required string path parameter, the ones with a regular expression have it as pattern.
+func toSwagPath(tpl string) (string, []generator.Param) {
+	var (
+		path   strings.Builder
+		params []generator.Param
+		depth  int
+		start  int
+	)
+
+	// the braces are counted as gorilla does, so the regular expressions may have quantifiers like {2}
+	for i := 0; i < len(tpl); i++ {
+		switch tpl[i] {
+		case '{':
+			if depth == 0 {
+				start = i + 1
+			}
+			depth++
+			continue
+		case '}':
+			depth--
+			if depth == 0 {
+				name, pattern, _ := strings.Cut(tpl[start:i], ":")
+				name = strings.TrimSpace(name)
+
+				param := generator.Param{Name: name, ParamType: "string", Required: true}
+				if pattern != "" {
+					param.Pattern = anchor(pattern)
+				}
+
+				params = append(params, param)
+				path.WriteString("{" + name + "}")
+			}
+			continue
+		}
+
+		if depth == 0 {
+			path.WriteByte(tpl[i])
+		}
+	}
+
+	return path.String(), params
+}
+
+// anchor anchors the pattern, gorilla matches the regular expression of a variable against the whole value.
+func anchor(pattern string) string {
+	if strings.Contains(pattern, "|") {
+		pattern = "(?:" + pattern + ")"
+	}
+
+	return "^" + pattern + "$"
+}
+
+// toGoSwagRoute converts a slice of gorillaOperation to a slice of generator.Route.
+func toGoSwagRoute(from []*gorillaOperation) []generator.Route {
+	var routes []generator.Route
+	for _, r := range from {
+		routes = append(routes, r.Route)
+	}
+
+	return routes
+}
+
+// toGoSwagGroup converts the sub-routers to generator.Group, named after their prefix.
+func toGoSwagGroup(from []*gorillaRouter) []generator.Group {
+	var groups []generator.Group
+	for _, g := range from {
+		groups = append(groups, generator.Group{
+			GroupName: g.groupName,
+			Routes:    toGoSwagRoute(g.routes),
+			Groups:    toGoSwagGroup(g.groups),
+		})
+	}
+
+	return groups
+}
@@ -1,6 +1,8 @@
 package http
 
 import (
+	"bufio"
+	"net"
 	"net/http"
 	"path"
 	"reflect"
@@ -46,6 +48,7 @@ func toGoSwagGroup(from []*httpGroup) []generator.Group {
 		groups = append(groups, generator.Group{
 			GroupName: g.groupName,
 			Routes:    toGoSwagRoute(g.routes),
+			Groups:    toGoSwagGroup(g.groups),
 		})
 	}
 
@@ -65,3 +68,67 @@ func getFullPath(groupName, relativePath string) string {
 
 	return fullPath
 }
+
+// chain wraps the handler with the middlewares, the first middleware is the outermost.
+func chain(h http.Handler, middlewares ...func(http.Handler) http.Handler) http.Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+
+	return h
+}
+
+// sequence returns a handler running the handlers in order, the last one should be the real handler.
+// The sequence stops after the first handler that writes a response, so the other handlers cannot write a second one.
+func sequence(handlers ...http.HandlerFunc) http.Handler {
+	if len(handlers) == 1 {
+		return handlers[0]
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rw := &responseWriter{ResponseWriter: w}
+		for _, h := range handlers {
+			h(rw, r)
+			if rw.written {
+				return
+			}
+		}
+	})
+}
+
+// responseWriter records whether a response has been written.
+type responseWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (w *responseWriter) WriteHeader(statusCode int) {
+	w.written = true
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	w.written = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush sends the buffered data to the client when the original writer supports it, streaming handlers need it.
+func (w *responseWriter) Flush() {
+	w.written = true
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Hijack lets the handler take over the connection when the original writer supports it, as websockets do.
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := http.NewResponseController(w.ResponseWriter).Hijack()
+	if err == nil {
+		w.written = true
+	}
+
+	return conn, rw, err
+}
+
+// Unwrap returns the original writer, so http.ResponseController can reach it.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
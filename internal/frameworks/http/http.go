@@ -3,16 +3,22 @@ package http
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/r0bertson/goswag/internal/docs"
 	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/internal/validator"
 	"github.com/r0bertson/goswag/models"
 )
 
 type httpSwagger struct {
-	mux              *http.ServeMux
+	mux *http.ServeMux
+	// middlewares are the global middlewares added with Use
+	middlewares      []func(http.Handler) http.Handler
 	groups           []*httpGroup
 	routes           []*httpRoute
 	defaultResponses []models.ReturnType
+	info             *models.Info
 }
 
 func NewHTTP(mux *http.ServeMux, defaultResponses ...models.ReturnType) *httpSwagger {
@@ -26,26 +32,126 @@ func (s *httpSwagger) Mux() *http.ServeMux {
 	return s.mux
 }
 
+// Handler returns the mux serving the routes, e.g. for httptest.
+func (s *httpSwagger) Handler() http.Handler {
+	return s.mux
+}
+
+// SetInfo sets the general information of the API written in the generated documentation.
+func (s *httpSwagger) SetInfo(info models.Info) {
+	s.info = &info
+}
+
 func (s *httpSwagger) GenerateSwagger() {
-	generator.GenerateSwagger(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses)
+	generator.GenerateSwagger(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
+}
+
+func (s *httpSwagger) GenerateSwaggerE(opts models.GenerateOptions) error {
+	if opts.Info == nil {
+		opts.Info = s.info
+	}
+
+	return generator.Generate(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, opts)
+}
+
+func (s *httpSwagger) GenerateOpenAPI() {
+	generator.GenerateOpenAPI(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
+}
+
+// ServeDocs mounts the Swagger UI, Redoc and the OpenAPI document of the routes under the prefix.
+// The docs routes are registered directly in the router, so they are not documented.
+func (s *httpSwagger) ServeDocs(prefix string) {
+	h := docs.Handler(prefix, s.OpenAPI)
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		// the subtree pattern of the root prefix, GET /, would match every path and conflict with
+		// a GET / route of the app, {$} only matches the root itself
+		for _, path := range docs.Paths {
+			if path == "/" {
+				path = "/{$}"
+			}
+
+			s.mux.Handle(fmt.Sprintf("%s %s", http.MethodGet, path), h)
+		}
+
+		return
+	}
+
+	s.mux.Handle(fmt.Sprintf("%s %s", http.MethodGet, prefix), h)
+	s.mux.Handle(fmt.Sprintf("%s %s/", http.MethodGet, prefix), h)
+}
+
+// ValidationMiddleware returns a middleware that rejects the requests that do not match the
+// documentation of their route. It wraps the mux, e.g. http.ListenAndServe(addr, mw(mux)).
+func (s *httpSwagger) ValidationMiddleware(opts ...models.ValidationOptions) func(http.Handler) http.Handler {
+	registry := validator.NewRegistry(func() ([]generator.Route, []generator.Group) {
+		return toGoSwagRoute(s.routes), toGoSwagGroup(s.groups)
+	}, opts...)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// the pattern of the route is only known by the mux, it may start with the method
+			_, pattern := s.mux.Handler(r)
+			if i := strings.Index(pattern, " "); i >= 0 {
+				pattern = pattern[i+1:]
+			}
+
+			params, _ := validator.MatchPath(pattern, r.URL.Path)
+			pathParam := func(name string) string { return params[name] }
+
+			if registry.Check(w, r, pattern, pathParam) {
+				next.ServeHTTP(w, r)
+			}
+		})
+	}
+}
+
+// OpenAPI returns the json of the OpenAPI 3.1 document of the routes registered so far.
+func (s *httpSwagger) OpenAPI() ([]byte, error) {
+	return generator.MarshalOpenAPI(toGoSwagRoute(s.routes), toGoSwagGroup(s.groups), s.defaultResponses, s.info)
+}
+
+func (s *httpSwagger) Group(relativePath string, middlewares ...func(http.Handler) http.Handler) models.HTTPGroup {
+	g := &httpGroup{
+		prefix:      relativePath,
+		mux:         s.mux,
+		groupName:   relativePath,
+		middlewares: append(append([]func(http.Handler) http.Handler(nil), s.middlewares...), middlewares...),
+	}
+	s.groups = append(s.groups, g)
+
+	return g
+}
+
+// Use appends global middlewares, they wrap the routes and groups registered afterwards.
+func (s *httpSwagger) Use(middlewares ...func(http.Handler) http.Handler) {
+	s.middlewares = append(s.middlewares, middlewares...)
 }
 
-func (s *httpSwagger) Group(relativePath string, handlers ...http.HandlerFunc) models.HTTPRouter {
-	g := &httpGroup{prefix: relativePath, mux: s.mux, groupName: relativePath}
+// With returns a router whose routes are wrapped by the middlewares after the global ones.
+// The routes are documented like the ones registered directly, without a tag.
+func (s *httpSwagger) With(middlewares ...func(http.Handler) http.Handler) models.HTTPRouter {
+	g := &httpGroup{
+		mux:         s.mux,
+		middlewares: append(append([]func(http.Handler) http.Handler(nil), s.middlewares...), middlewares...),
+	}
 	s.groups = append(s.groups, g)
 
 	return g
 }
 
+// Handle registers the route with a method pattern, so the mux answers 405 with the Allow header
+// to the requests of a method that is not registered for the path.
 func (s *httpSwagger) Handle(httpMethod, relativePath string, handlers ...http.HandlerFunc) models.Swagger {
-	// For net/http, we need to create a custom handler that checks the method
-	handler := createMethodHandler(httpMethod, handlers...)
+	handler := chain(sequence(handlers...), s.middlewares...)
 	s.mux.Handle(fmt.Sprintf("%s %s", httpMethod, relativePath), handler)
+	tpl, params := generator.PathTemplate(relativePath)
 	hr := &httpRoute{
 		Route: generator.Route{
-			Path:     relativePath,
-			Method:   httpMethod,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     httpMethod,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -54,6 +160,46 @@ func (s *httpSwagger) Handle(httpMethod, relativePath string, handlers ...http.H
 	return hr
 }
 
+// Any registers the route without a method pattern, so it serves every method.
+// The methods of generator.AnyMethods are documented.
+func (s *httpSwagger) Any(relativePath string, handlers ...http.HandlerFunc) models.MultiSwagger {
+	handler := chain(sequence(handlers...), s.middlewares...)
+	s.mux.Handle(relativePath, handler)
+
+	return s.document(generator.AnyMethods, relativePath, handlers...)
+}
+
+// Match registers the route with a method pattern per method.
+func (s *httpSwagger) Match(methods []string, relativePath string, handlers ...http.HandlerFunc) models.MultiSwagger {
+	handler := chain(sequence(handlers...), s.middlewares...)
+	for _, method := range methods {
+		s.mux.Handle(fmt.Sprintf("%s %s", strings.ToUpper(method), relativePath), handler)
+	}
+
+	return s.document(methods, relativePath, handlers...)
+}
+
+// document adds one route per method, the handler is registered by the caller.
+func (s *httpSwagger) document(methods []string, fullPath string, handlers ...http.HandlerFunc) httpOperations {
+	operations := make(httpOperations, 0, len(methods))
+	for _, method := range methods {
+		tpl, params := generator.PathTemplate(fullPath)
+		hr := &httpRoute{
+			Route: generator.Route{
+				Path:       tpl,
+				Method:     strings.ToUpper(method),
+				FuncName:   getFuncName(handlers...),
+				PathParams: params,
+			},
+		}
+
+		s.routes = append(s.routes, hr)
+		operations = append(operations, hr)
+	}
+
+	return operations
+}
+
 func (s *httpSwagger) POST(relativePath string, handlers ...http.HandlerFunc) models.Swagger {
 	return s.Handle(http.MethodPost, relativePath, handlers...)
 }
@@ -86,19 +232,59 @@ type httpGroup struct {
 	prefix    string
 	mux       *http.ServeMux
 	groupName string
-	routes    []*httpRoute
+	// middlewares are the middlewares of the parent groups followed by the ones of the group,
+	// the first one is the outermost
+	middlewares []func(http.Handler) http.Handler
+	groups      []*httpGroup
+	routes      []*httpRoute
+}
+
+// Use appends middlewares to the group, they wrap the routes and sub-groups registered afterwards.
+func (g *httpGroup) Use(middlewares ...func(http.Handler) http.Handler) {
+	g.middlewares = append(g.middlewares, middlewares...)
+}
+
+// Group creates a new sub-group with prefix and optional sub-group-level middlewares,
+// the middlewares of the parent groups wrap them.
+func (g *httpGroup) Group(relativePath string, middlewares ...func(http.Handler) http.Handler) models.HTTPGroup {
+	sub := &httpGroup{
+		prefix:      getFullPath(g.prefix, relativePath),
+		mux:         g.mux,
+		groupName:   relativePath,
+		middlewares: append(append([]func(http.Handler) http.Handler(nil), g.middlewares...), middlewares...),
+	}
+	g.groups = append(g.groups, sub)
+
+	return sub
+}
+
+// With returns a router with the prefix of the group whose routes are wrapped by the middlewares
+// after the ones of the group. The routes are documented with the tags of the group.
+func (g *httpGroup) With(middlewares ...func(http.Handler) http.Handler) models.HTTPRouter {
+	sub := &httpGroup{
+		prefix:      g.prefix,
+		mux:         g.mux,
+		middlewares: append(append([]func(http.Handler) http.Handler(nil), g.middlewares...), middlewares...),
+	}
+	g.groups = append(g.groups, sub)
+
+	return sub
 }
 
+// Handle registers the route with a method pattern, so the mux answers 405 with the Allow header
+// to the requests of a method that is not registered for the path.
 func (g *httpGroup) Handle(httpMethod, relativePath string, handlers ...http.HandlerFunc) models.Swagger {
 	fullPath := getFullPath(g.prefix, relativePath)
-	handler := createMethodHandler(httpMethod, handlers...)
-	g.mux.Handle(fullPath, handler)
+	handler := chain(sequence(handlers...), g.middlewares...)
+	g.mux.Handle(fmt.Sprintf("%s %s", httpMethod, fullPath), handler)
 
+	tpl, params := generator.PathTemplate(fullPath)
 	hr := &httpRoute{
 		Route: generator.Route{
-			Path:     fullPath,
-			Method:   httpMethod,
-			FuncName: getFuncName(handlers...),
+			Path:       tpl,
+			Method:     httpMethod,
+			FuncName:   getFuncName(handlers...),
+			PathParams: params,
 		},
 	}
 
@@ -107,6 +293,48 @@ func (g *httpGroup) Handle(httpMethod, relativePath string, handlers ...http.Han
 	return hr
 }
 
+// Any registers the route without a method pattern, so it serves every method.
+// The methods of generator.AnyMethods are documented.
+func (g *httpGroup) Any(relativePath string, handlers ...http.HandlerFunc) models.MultiSwagger {
+	fullPath := getFullPath(g.prefix, relativePath)
+	handler := chain(sequence(handlers...), g.middlewares...)
+	g.mux.Handle(fullPath, handler)
+
+	return g.document(generator.AnyMethods, fullPath, handlers...)
+}
+
+// Match registers the route with a method pattern per method.
+func (g *httpGroup) Match(methods []string, relativePath string, handlers ...http.HandlerFunc) models.MultiSwagger {
+	fullPath := getFullPath(g.prefix, relativePath)
+	handler := chain(sequence(handlers...), g.middlewares...)
+	for _, method := range methods {
+		g.mux.Handle(fmt.Sprintf("%s %s", strings.ToUpper(method), fullPath), handler)
+	}
+
+	return g.document(methods, fullPath, handlers...)
+}
+
+// document adds one route per method, the handler is registered by the caller.
+func (g *httpGroup) document(methods []string, fullPath string, handlers ...http.HandlerFunc) httpOperations {
+	operations := make(httpOperations, 0, len(methods))
+	for _, method := range methods {
+		tpl, params := generator.PathTemplate(fullPath)
+		hr := &httpRoute{
+			Route: generator.Route{
+				Path:       tpl,
+				Method:     strings.ToUpper(method),
+				FuncName:   getFuncName(handlers...),
+				PathParams: params,
+			},
+		}
+
+		g.routes = append(g.routes, hr)
+		operations = append(operations, hr)
+	}
+
+	return operations
+}
+
 func (g *httpGroup) POST(relativePath string, handlers ...http.HandlerFunc) models.Swagger {
 	return g.Handle(http.MethodPost, relativePath, handlers...)
 }
@@ -179,33 +407,44 @@ func (r *httpRoute) Returns(returns []models.ReturnType) models.Swagger {
 	return r
 }
 
-func (r *httpRoute) QueryParam(name, description, paramType string, required bool) models.Swagger {
-	r.Route.QueryParams = append(r.Route.QueryParams, generator.Param{
-		Name:        name,
-		Description: description,
-		ParamType:   paramType,
-		Required:    required,
-	})
+func (r *httpRoute) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.QueryParams = append(r.Route.QueryParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *httpRoute) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.HeaderParams = append(r.Route.HeaderParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+// PathParam refines the parameter declared from the path variable with the same name.
+func (r *httpRoute) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.PathParams = generator.SetParam(r.Route.PathParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *httpRoute) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.CookieParams = append(r.Route.CookieParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *httpRoute) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, paramType, required, opts...))
 	return r
 }
 
-func (r *httpRoute) HeaderParam(name, description, paramType string, required bool) models.Swagger {
-	r.Route.HeaderParams = append(r.Route.HeaderParams, generator.Param{
-		Name:        name,
-		Description: description,
-		ParamType:   paramType,
-		Required:    required,
-	})
+func (r *httpRoute) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, "file", required, opts...))
 	return r
 }
 
-func (r *httpRoute) PathParam(name, description, paramType string, required bool) models.Swagger {
-	r.Route.PathParams = append(r.Route.PathParams, generator.Param{
-		Name:        name,
-		Description: description,
-		ParamType:   paramType,
-		Required:    required,
-	})
+// Params declares the query, header and path parameters read from the tags of the fields of a struct.
+func (r *httpRoute) Params(v any) models.Swagger {
+	path, query, header := generator.StructParams(v, r.Route.Method)
+	r.Route.PathParams = generator.SetParam(r.Route.PathParams, path...)
+	r.Route.QueryParams = generator.SetParam(r.Route.QueryParams, query...)
+	r.Route.HeaderParams = generator.SetParam(r.Route.HeaderParams, header...)
+
 	return r
 }
 
@@ -214,17 +453,88 @@ func (r *httpRoute) Security(schemes ...string) models.Swagger {
 	return r
 }
 
-// createMethodHandler creates a handler that checks the HTTP method before executing the handlers
-func createMethodHandler(method string, handlers ...http.HandlerFunc) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != method {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
+// httpOperations applies the annotations to the routes of all the methods of a path.
+type httpOperations []*httpRoute
+
+func (ops httpOperations) each(fn func(r *httpRoute)) models.Swagger {
+	for _, r := range ops {
+		fn(r)
+	}
 
-		// Execute all handlers in sequence
-		for _, handler := range handlers {
-			handler(w, r)
+	return ops
+}
+
+// Method returns the route of the method, or a detached one that is not documented.
+func (ops httpOperations) Method(method string) models.Swagger {
+	for _, r := range ops {
+		if strings.EqualFold(r.Route.Method, method) {
+			return r
 		}
-	})
+	}
+
+	return &httpRoute{}
+}
+
+func (ops httpOperations) Summary(summary string) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.Summary(summary) })
+}
+
+func (ops httpOperations) Description(description string) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.Description(description) })
+}
+
+func (ops httpOperations) Tags(tags ...string) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.Tags(tags...) })
+}
+
+func (ops httpOperations) Accepts(accepts ...string) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.Accepts(accepts...) })
+}
+
+func (ops httpOperations) Produces(produces ...string) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.Produces(produces...) })
+}
+
+func (ops httpOperations) Read(reads interface{}) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.Read(reads) })
+}
+
+func (ops httpOperations) ReadFieldDescriptions(descriptions map[string]string) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.ReadFieldDescriptions(descriptions) })
+}
+
+func (ops httpOperations) Returns(returns []models.ReturnType) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.Returns(returns) })
+}
+
+func (ops httpOperations) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.QueryParam(name, description, paramType, required, opts...) })
+}
+
+func (ops httpOperations) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.HeaderParam(name, description, paramType, required, opts...) })
+}
+
+func (ops httpOperations) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.PathParam(name, description, paramType, required, opts...) })
+}
+
+func (ops httpOperations) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.CookieParam(name, description, paramType, required, opts...) })
+}
+
+func (ops httpOperations) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.FormParam(name, description, paramType, required, opts...) })
+}
+
+func (ops httpOperations) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.FileParam(name, description, required, opts...) })
+}
+
+func (ops httpOperations) Params(v any) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.Params(v) })
+}
+
+func (ops httpOperations) Security(schemes ...string) models.Swagger {
+	return ops.each(func(r *httpRoute) { r.Security(schemes...) })
 }
@@ -1,10 +1,14 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/r0bertson/goswag/internal/generator"
 	"github.com/r0bertson/goswag/models"
 )
 
@@ -113,6 +117,270 @@ func TestHTTP_Group(t *testing.T) {
 	}
 }
 
+func TestHTTP_NestedGroups(t *testing.T) {
+	mux := http.NewServeMux()
+	swagger := NewHTTP(mux)
+
+	var calls []string
+	middleware := func(name string) func(http.Handler) http.Handler {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, name)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "handler")
+		w.WriteHeader(http.StatusOK)
+	}
+
+	users := swagger.Group("/api", middleware("api")).Group("/v1", middleware("v1")).Group("/users")
+	users.GET("/", handler)
+
+	req := httptest.NewRequest("GET", "/api/v1/users/", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+
+	if got := strings.Join(calls, ","); got != "api,v1,handler" {
+		t.Errorf("Expected the middlewares of the parent groups to run first, got %s", got)
+	}
+
+	groups := toGoSwagGroup(swagger.groups)
+	if len(groups) != 1 || len(groups[0].Groups) != 1 || len(groups[0].Groups[0].Groups) != 1 {
+		t.Fatalf("Expected three nested groups, got %+v", groups)
+	}
+
+	inner := groups[0].Groups[0].Groups[0]
+	if inner.GroupName != "/users" {
+		t.Errorf("Expected group name /users, got %s", inner.GroupName)
+	}
+
+	if len(inner.Routes) != 1 || inner.Routes[0].Path != "/api/v1/users/" || inner.Routes[0].FuncName != "func2" {
+		t.Errorf("Expected the route /api/v1/users/ named after its handler, got %+v", inner.Routes)
+	}
+}
+
+func TestHTTP_GroupMiddlewares(t *testing.T) {
+	mux := http.NewServeMux()
+	swagger := NewHTTP(mux)
+
+	var handlerCalled bool
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+		w.WriteHeader(http.StatusOK)
+	}
+	auth := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "" {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	swagger.Group("/admin", auth).GET("/settings", handler)
+
+	req := httptest.NewRequest("GET", "/admin/settings", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized || handlerCalled {
+		t.Errorf("Expected the group middleware to stop the request, got status %d", w.Code)
+	}
+
+	req.Header.Set("Authorization", "token")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK || !handlerCalled {
+		t.Errorf("Expected the handler to be called, got status %d", w.Code)
+	}
+}
+
+func TestHTTP_MiddlewareChain(t *testing.T) {
+	mux := http.NewServeMux()
+	swagger := NewHTTP(mux)
+
+	var calls []string
+	middleware := func(name string) func(http.Handler) http.Handler {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, name)
+				if r.URL.Query().Get("stop") == name {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+
+	swagger.Use(middleware("global"))
+	users := swagger.Group("/users", middleware("group"))
+	users.Use(middleware("group-use"))
+	route := users.With(middleware("route")).GET("/{id}", handleGetUser)
+
+	if got := route.(*httpRoute).Route.FuncName; got != "handleGetUser" {
+		t.Errorf("Expected the function name of the terminal handler, got %s", got)
+	}
+
+	doc := generator.BuildOpenAPI(toGoSwagRoute(swagger.routes), toGoSwagGroup(swagger.groups), nil, nil)
+	if got := doc.Paths["/users/{id}"]["get"].Tags; !reflect.DeepEqual(got, []string{"/users"}) {
+		t.Errorf("Expected the route registered with With to have the tags of its group, got %v", got)
+	}
+
+	tests := []struct {
+		query     string
+		wantCode  int
+		wantCalls string
+	}{
+		{query: "", wantCode: http.StatusOK, wantCalls: "global,group,group-use,route,handler"},
+		{query: "stop=group", wantCode: http.StatusForbidden, wantCalls: "global,group"},
+		{query: "stop=route", wantCode: http.StatusForbidden, wantCalls: "global,group,group-use,route"},
+	}
+
+	for _, tt := range tests {
+		calls = nil
+
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest("GET", "/users/1?"+tt.query, nil))
+
+		if w.Code != tt.wantCode {
+			t.Errorf("Expected status code %d for %q, got %d", tt.wantCode, tt.query, w.Code)
+		}
+
+		if got := strings.Join(append(calls, handlerCalls(w)...), ","); got != tt.wantCalls {
+			t.Errorf("Expected the calls %s for %q, got %s", tt.wantCalls, tt.query, got)
+		}
+	}
+}
+
+func TestHTTP_HandlerSequence(t *testing.T) {
+	mux := http.NewServeMux()
+	swagger := NewHTTP(mux)
+
+	var calls []string
+	auth := func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "auth")
+		if r.Header.Get("Authorization") == "" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+		}
+	}
+
+	route := swagger.GET("/users/{id}", auth, handleGetUser)
+
+	if got := route.(*httpRoute).Route.FuncName; got != "handleGetUser" {
+		t.Errorf("Expected the function name of the last handler, got %s", got)
+	}
+
+	req := httptest.NewRequest("GET", "/users/1", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	if got := strings.Join(append(calls, handlerCalls(w)...), ","); w.Code != http.StatusUnauthorized || got != "auth" {
+		t.Errorf("Expected the first handler to stop the sequence, got status %d and the calls %s", w.Code, got)
+	}
+
+	calls = nil
+	req.Header.Set("Authorization", "token")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	if got := strings.Join(append(calls, handlerCalls(w)...), ","); w.Code != http.StatusOK || got != "auth,handler" {
+		t.Errorf("Expected the handlers to run in order, got status %d and the calls %s", w.Code, got)
+	}
+}
+
+func TestHTTP_HandlerSequenceFlush(t *testing.T) {
+	mux := http.NewServeMux()
+	swagger := NewHTTP(mux)
+
+	var calls []string
+	logger := func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "logger")
+	}
+
+	stream := func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			t.Fatal("Expected the writer of the sequence to be a http.Flusher")
+		}
+
+		_, _ = w.Write([]byte("data: 1\n\n"))
+		flusher.Flush()
+		calls = append(calls, "stream")
+	}
+
+	swagger.GET("/events", logger, stream)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/events", nil))
+
+	if got := strings.Join(calls, ","); !w.Flushed || got != "logger,stream" {
+		t.Errorf("Expected the flush to reach the original writer, got flushed %v and the calls %s", w.Flushed, got)
+	}
+
+	// the recorder can not be hijacked, the error of the original writer is returned
+	rw := &responseWriter{ResponseWriter: httptest.NewRecorder()}
+	if _, _, err := rw.Hijack(); !errors.Is(err, http.ErrNotSupported) || rw.written {
+		t.Errorf("Expected the hijack to be forwarded to the original writer, got %v", err)
+	}
+}
+
+func handleGetUser(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("X-Handler", "handler")
+	w.WriteHeader(http.StatusOK)
+}
+
+func handlerCalls(w *httptest.ResponseRecorder) []string {
+	if h := w.Header().Get("X-Handler"); h != "" {
+		return []string{h}
+	}
+
+	return nil
+}
+
+func TestHTTP_GroupMethodNotAllowed(t *testing.T) {
+	mux := http.NewServeMux()
+	swagger := NewHTTP(mux)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	users := swagger.Group("/users")
+	users.GET("/{id}", handler)
+	users.PUT("/{id}", handler)
+
+	for _, method := range []string{"GET", "PUT"} {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(method, "/users/1", nil))
+
+		if w.Code != http.StatusOK {
+			t.Errorf("Expected status code %d for %s, got %d", http.StatusOK, method, w.Code)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("DELETE", "/users/1", nil))
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status code %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+
+	if allow := w.Header().Get("Allow"); allow != "GET, HEAD, PUT" {
+		t.Errorf("Expected the Allow header to list the methods of the path, got %q", allow)
+	}
+}
+
 func TestHTTP_MethodNotAllowed(t *testing.T) {
 	mux := http.NewServeMux()
 	swagger := NewHTTP(mux)
@@ -298,3 +566,182 @@ func TestHTTPRoute_ReturnsWithFieldDescriptions(t *testing.T) {
 		t.Errorf("Expected id description to be 'Unique identifier for the user', got '%s'", returnType.FieldDescriptions["id"])
 	}
 }
+
+func TestHTTP_ServeDocs(t *testing.T) {
+	for prefix, base := range map[string]string{"/docs": "/docs", "/docs/": "/docs", "/": ""} {
+		mux := http.NewServeMux()
+		swagger := NewHTTP(mux)
+		swagger.ServeDocs(prefix)
+		swagger.GET("/users/{id}", func(w http.ResponseWriter, r *http.Request) {}).Summary("Get user")
+
+		for path, contains := range map[string]string{
+			base + "/":             "swagger-ui",
+			base + "/redoc":        "redoc",
+			base + "/openapi.json": `"/users/{id}"`,
+			base + "/openapi.yaml": "summary: Get user",
+			"/users/1":             "",
+		} {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+			if w.Code != http.StatusOK {
+				t.Errorf("Expected status code %d for %s%s, got %d", http.StatusOK, prefix, path, w.Code)
+			}
+
+			if !strings.Contains(w.Body.String(), contains) {
+				t.Errorf("Expected %s to contain %q, got %s", path, contains, w.Body.String())
+			}
+		}
+	}
+}
+
+func TestHTTP_ServeDocsRootUnmatched(t *testing.T) {
+	mux := http.NewServeMux()
+	swagger := NewHTTP(mux)
+	swagger.ServeDocs("/")
+	swagger.GET("/users/{id}", func(w http.ResponseWriter, r *http.Request) {})
+
+	// the docs only serve their own paths, the other GET requests are not matched
+	for path, want := range map[string]int{
+		"/":             http.StatusOK,
+		"/openapi.json": http.StatusOK,
+		"/users/1":      http.StatusOK,
+		"/unknown":      http.StatusNotFound,
+	} {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		if w.Code != want {
+			t.Errorf("Expected status code %d for %s, got %d", want, path, w.Code)
+		}
+	}
+
+	// a GET / route of the app does not conflict with the docs
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Expected GET / to be registered next to the docs, got %v", r)
+		}
+	}()
+	swagger.GET("/", func(w http.ResponseWriter, r *http.Request) {})
+}
+
+func TestHTTP_ValidationMiddleware(t *testing.T) {
+	mux := http.NewServeMux()
+	swagger := NewHTTP(mux)
+	swagger.GET("/users/{id}", func(w http.ResponseWriter, r *http.Request) {}).
+		PathParam("id", "user id", "int", true).
+		HeaderParam("X-Tenant", "tenant", "string", true)
+	handler := swagger.ValidationMiddleware()(mux)
+
+	for path, want := range map[string]int{
+		"/users/1": http.StatusOK,
+		"/users/a": http.StatusBadRequest,
+		"/items/1": http.StatusNotFound,
+	} {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		r.Header.Set("X-Tenant", "acme")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		if w.Code != want {
+			t.Errorf("Expected status code %d for %s, got %d", want, path, w.Code)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users/1", nil))
+
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), `"name":"X-Tenant"`) {
+		t.Errorf("Expected the missing header to be reported, got %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestHTTP_AnyMatch(t *testing.T) {
+	mux := http.NewServeMux()
+	swagger := NewHTTP(mux)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	files := swagger.Group("/files")
+	m := files.Match([]string{"get", "PUT"}, "/{id}", handler)
+	m.Summary("Get or replace file").Tags("files")
+	m.Method("PUT").Summary("Replace file")
+	m.Method("POST").Summary("not documented")
+	swagger.Any("/echo", handler).Summary("Echo")
+
+	if len(swagger.routes) != len(generator.AnyMethods) {
+		t.Fatalf("Expected %d routes for Any, got %d", len(generator.AnyMethods), len(swagger.routes))
+	}
+
+	for i, r := range swagger.routes {
+		if r.Route.Path != "/echo" || r.Route.Method != generator.AnyMethods[i] || r.Route.Summary != "Echo" {
+			t.Errorf("Unexpected route for Any: %+v", r.Route)
+		}
+	}
+
+	routes := swagger.groups[0].routes
+	if len(routes) != 2 {
+		t.Fatalf("Expected 2 routes for Match, got %d", len(routes))
+	}
+
+	for i, want := range []struct{ method, summary string }{{"GET", "Get or replace file"}, {"PUT", "Replace file"}} {
+		r := routes[i].Route
+		if r.Path != "/files/{id}" || r.Method != want.method || r.Summary != want.summary || len(r.Tags) != 1 {
+			t.Errorf("Unexpected route for Match: %+v", r)
+		}
+	}
+
+	for method, want := range map[string]int{"GET": http.StatusOK, "PUT": http.StatusOK, "POST": http.StatusMethodNotAllowed} {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(method, "/files/1", nil))
+
+		if w.Code != want {
+			t.Errorf("Expected status code %d for %s, got %d", want, method, w.Code)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("TRACE", "/echo", nil))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHTTPRoute_PathParams(t *testing.T) {
+	mux := http.NewServeMux()
+	swagger := NewHTTP(mux)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	swagger.GET("/users/{id}/files/{path...}", handler).PathParam("id", "user id", "int", true)
+	swagger.GET("/{$}", handler)
+
+	route := swagger.routes[0].Route
+	if route.Path != "/users/{id}/files/{path}" {
+		t.Errorf("Expected path /users/{id}/files/{path}, got %s", route.Path)
+	}
+
+	want := []generator.Param{
+		{Name: "id", Description: "user id", ParamType: "int", Required: true},
+		{Name: "path", ParamType: "string", Required: true},
+	}
+
+	if len(route.PathParams) != len(want) {
+		t.Fatalf("Expected %d path params, got %+v", len(want), route.PathParams)
+	}
+
+	for i, p := range want {
+		if !reflect.DeepEqual(route.PathParams[i], p) {
+			t.Errorf("Expected path param %+v, got %+v", p, route.PathParams[i])
+		}
+	}
+
+	if root := swagger.routes[1].Route; root.Path != "/" || len(root.PathParams) != 0 {
+		t.Errorf("Expected the root path without params, got %s %+v", root.Path, root.PathParams)
+	}
+}
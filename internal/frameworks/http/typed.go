@@ -0,0 +1,130 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/internal/typed"
+	"github.com/r0bertson/goswag/models"
+)
+
+// Handle registers the typed handler for the method. The request is decoded from the json body
+// for POST, PUT and PATCH, its fields with a parameter tag are bound from the path, the query and the
+// headers for every method, and the response is written as json.
+// The errors are answered as {"message": "..."}.
+func Handle[Req, Resp any](router models.HTTPRouter, method, path string, h func(context.Context, Req) (Resp, error)) models.Swagger {
+	route := router.Handle(method, path, func(w http.ResponseWriter, r *http.Request) {
+		var req Req
+		if typed.HasBody(method) && !typed.IsEmpty(req) {
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"message": "invalid json body: " + err.Error()})
+				return
+			}
+		}
+
+		if !typed.IsEmpty(req) {
+			if err := bindParams(r, method, reflect.ValueOf(&req).Elem()); err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+				return
+			}
+		}
+
+		resp, err := h(r.Context(), req)
+		if err != nil {
+			status, message := typed.ErrorResponse(err)
+			writeJSON(w, status, map[string]string{"message": message})
+			return
+		}
+
+		if typed.IsEmpty(resp) {
+			w.WriteHeader(typed.Status(method))
+			return
+		}
+
+		writeJSON(w, typed.Status(method), resp)
+	})
+
+	if r, ok := route.(*httpRoute); ok {
+		r.Route.FuncName = typed.FuncName(h)
+		r.Route.Success = typed.Success[Resp](method)
+	}
+
+	return typed.Annotate[Req](route, method)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// bindParams sets the fields of v declared as parameters by their tags, as documented by
+// generator.StructParams for the method, from the path values, the query and the headers of the request.
+// The parameters missing from the request leave their fields unchanged.
+func bindParams(r *http.Request, method string, v reflect.Value) error {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		in, name, _, ok := generator.ParamTag(f.Tag)
+		if !ok {
+			if f.Anonymous && f.IsExported() {
+				if err := bindParams(r, method, v.Field(i)); err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		var values []string
+		switch in {
+		case "path":
+			if value := r.PathValue(name); value != "" {
+				values = []string{value}
+			}
+		case "query":
+			values = r.URL.Query()[name]
+		case "form":
+			if !typed.HasBody(method) {
+				values = r.URL.Query()[name]
+			}
+		default:
+			values = r.Header.Values(name)
+		}
+
+		if len(values) == 0 {
+			continue
+		}
+
+		if err := typed.SetField(v.Field(i), values); err != nil {
+			return fmt.Errorf("invalid %s parameter %s: %w", in, name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/r0bertson/goswag/models"
+)
+
+type createUser struct {
+	Name string `json:"name"`
+}
+
+type user struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func createUserHandler(ctx context.Context, req createUser) (user, error) {
+	if req.Name == "" {
+		return user{}, &models.StatusError{Status: http.StatusUnprocessableEntity, Err: errors.New("name is required")}
+	}
+
+	return user{ID: "1", Name: req.Name}, nil
+}
+
+func TestHandle(t *testing.T) {
+	mux := http.NewServeMux()
+	swagger := NewHTTP(mux)
+
+	Handle(swagger.Group("/users"), http.MethodPost, "", createUserHandler).Summary("Create user")
+
+	route := swagger.groups[0].routes[0].Route
+	if route.FuncName != "createUserHandler" || route.Summary != "Create user" {
+		t.Errorf("Expected the route to be named after the handler, got %+v", route)
+	}
+
+	if _, ok := route.Reads.(createUser); !ok {
+		t.Errorf("Expected the route to read createUser, got %T", route.Reads)
+	}
+
+	if route.Success == nil || route.Success.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected a 201 response, got %+v", route.Success)
+	}
+
+	if _, ok := route.Success.Body.(user); !ok {
+		t.Errorf("Expected the response to be user, got %T", route.Success.Body)
+	}
+
+	tests := []struct {
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{body: `{"name":"Ada"}`, wantStatus: http.StatusCreated, wantBody: `{"id":"1","name":"Ada"}`},
+		{body: `{}`, wantStatus: http.StatusUnprocessableEntity, wantBody: `{"message":"name is required"}`},
+		{body: `{`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(tt.body)))
+
+		if w.Code != tt.wantStatus {
+			t.Errorf("Expected status code %d for %s, got %d", tt.wantStatus, tt.body, w.Code)
+		}
+
+		if got := strings.TrimSpace(w.Body.String()); tt.wantBody != "" && got != tt.wantBody {
+			t.Errorf("Expected body %s, got %s", tt.wantBody, got)
+		}
+
+		if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Expected a json response, got %q", ct)
+		}
+	}
+}
+
+type listUsers struct {
+	Tenant string   `path:"tenant"`
+	Page   int      `query:"page"`
+	Tags   []string `query:"tag"`
+	Token  *string  `header:"X-Token"`
+}
+
+func TestHandle_bindParams(t *testing.T) {
+	mux := http.NewServeMux()
+	swagger := NewHTTP(mux)
+
+	var got listUsers
+	Handle(swagger, http.MethodGet, "/tenants/{tenant}/users", func(ctx context.Context, req listUsers) (struct{}, error) {
+		got = req
+		return struct{}{}, nil
+	})
+
+	route := swagger.routes[0].Route
+	if len(route.QueryParams) != 2 || len(route.HeaderParams) != 1 || route.Reads != nil {
+		t.Errorf("Expected the params of listUsers without body, got %+v", route)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/tenants/acme/users?page=2&tag=a&tag=b", nil)
+	req.Header.Set("X-Token", "secret")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+
+	if got.Tenant != "acme" || got.Page != 2 || strings.Join(got.Tags, ",") != "a,b" || got.Token == nil || *got.Token != "secret" {
+		t.Errorf("Expected the request to be bound from the path, the query and the headers, got %+v", got)
+	}
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/tenants/acme/users?page=two", nil))
+
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "invalid query parameter page") {
+		t.Errorf("Expected a bad request for an invalid parameter, got %d %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,57 @@
+package httprouter
+
+import (
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/r0bertson/goswag/internal/generator"
+)
+
+// getFuncName retrieves the name of the function of the handle.
+// It uses the reflect package to obtain the function name from the pointer value of the handle.
+// The function name is extracted by splitting the full function name string using the dot separator and returning the last element.
+func getFuncName(handle httprouter.Handle) string {
+	return funcName(runtime.FuncForPC(reflect.ValueOf(handle).Pointer()).Name())
+}
+
+// getHandlerName retrieves the name of the function of the handler, handlers that are not functions are named after their type.
+func getHandlerName(h http.Handler) string {
+	if hf, ok := h.(http.HandlerFunc); ok {
+		return funcName(runtime.FuncForPC(reflect.ValueOf(hf).Pointer()).Name())
+	}
+
+	return funcName(reflect.Indirect(reflect.ValueOf(h)).Type().Name())
+}
+
+func funcName(fullFuncName string) string {
+	funcNameSplit := strings.Split(fullFuncName, ".")
+	name := funcNameSplit[len(funcNameSplit)-1]
+
+	return strings.TrimSuffix(name, "-fm")
+}
+
+// toSwagPath translates the httprouter path syntax to a path template:
+// the named parameters :name and the catch-all parameters *name become {name}.
+func toSwagPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// toGoSwagRoute converts a slice of httprouterRoute to a slice of generator.Route.
+func toGoSwagRoute(from []*httprouterRoute) []generator.Route {
+	var routes []generator.Route
+	for _, r := range from {
+		routes = append(routes, r.Route)
+	}
+
+	return routes
+}
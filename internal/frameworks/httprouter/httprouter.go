@@ -0,0 +1,367 @@
+package httprouter
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/r0bertson/goswag/internal/docs"
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/internal/validator"
+	"github.com/r0bertson/goswag/models"
+)
+
+type httprouterSwagger struct {
+	router           *httprouter.Router
+	routes           []*httprouterRoute
+	defaultResponses []models.ReturnType
+	info             *models.Info
+}
+
+func NewHTTPRouter(router *httprouter.Router, defaultResponses ...models.ReturnType) *httprouterSwagger {
+	return &httprouterSwagger{
+		router:           router,
+		defaultResponses: defaultResponses,
+	}
+}
+
+func (s *httprouterSwagger) Router() *httprouter.Router {
+	return s.router
+}
+
+// ServeHTTP serves the requests with the httprouter router, so the wrapper can be served directly.
+func (s *httprouterSwagger) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.router.ServeHTTP(w, r)
+}
+
+// SetInfo sets the general information of the API written in the generated documentation.
+func (s *httprouterSwagger) SetInfo(info models.Info) {
+	s.info = &info
+}
+
+func (s *httprouterSwagger) GenerateSwagger() {
+	generator.GenerateSwagger(toGoSwagRoute(s.routes), nil, s.defaultResponses, s.info)
+}
+
+func (s *httprouterSwagger) GenerateSwaggerE(opts models.GenerateOptions) error {
+	if opts.Info == nil {
+		opts.Info = s.info
+	}
+
+	return generator.Generate(toGoSwagRoute(s.routes), nil, s.defaultResponses, opts)
+}
+
+func (s *httprouterSwagger) GenerateOpenAPI() {
+	generator.GenerateOpenAPI(toGoSwagRoute(s.routes), nil, s.defaultResponses, s.info)
+}
+
+// ServeDocs mounts the Swagger UI, Redoc and the OpenAPI document of the routes under the prefix.
+// The docs routes are registered directly in the router, so they are not documented.
+func (s *httprouterSwagger) ServeDocs(prefix string) {
+	h := docs.Handler(prefix, s.OpenAPI)
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		// a catch-all at the root would conflict with every other route
+		for _, path := range docs.Paths {
+			s.router.Handler(http.MethodGet, path, h)
+		}
+
+		return
+	}
+
+	s.router.Handler(http.MethodGet, prefix, h)
+	s.router.Handler(http.MethodGet, prefix+"/*filepath", h)
+}
+
+// ValidationMiddleware returns a middleware that rejects the requests that do not match the
+// documentation of their route. It wraps the router, e.g. http.ListenAndServe(addr, mw(router)).
+func (s *httprouterSwagger) ValidationMiddleware(opts ...models.ValidationOptions) func(http.Handler) http.Handler {
+	registry := validator.NewRegistry(func() ([]generator.Route, []generator.Group) {
+		return toGoSwagRoute(s.routes), nil
+	}, opts...)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// httprouter does not expose the matched path, the routes never conflict
+			// so the first documented route that matches is the one that handles the request
+			for _, route := range s.routes {
+				if route.Route.Method != r.Method {
+					continue
+				}
+
+				if params, ok := validator.MatchPath(route.path, r.URL.Path); ok {
+					if !registry.Check(w, r, route.Route.Path, func(name string) string { return params[name] }) {
+						return
+					}
+
+					break
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// OpenAPI returns the json of the OpenAPI 3.1 document of the routes registered so far.
+func (s *httprouterSwagger) OpenAPI() ([]byte, error) {
+	return generator.MarshalOpenAPI(toGoSwagRoute(s.routes), nil, s.defaultResponses, s.info)
+}
+
+func (s *httprouterSwagger) add(method, path, funcName string) *httprouterRoute {
+	tpl, params := generator.PathTemplate(toSwagPath(path))
+	hr := &httprouterRoute{
+		Route: generator.Route{
+			Path:       tpl,
+			Method:     method,
+			FuncName:   funcName,
+			PathParams: params,
+		},
+		path: path,
+	}
+
+	s.routes = append(s.routes, hr)
+
+	return hr
+}
+
+func (s *httprouterSwagger) Handle(method, path string, handle httprouter.Handle) models.Swagger {
+	s.router.Handle(method, path, handle)
+	return s.add(method, path, getFuncName(handle))
+}
+
+func (s *httprouterSwagger) Handler(method, path string, handler http.Handler) models.Swagger {
+	s.router.Handler(method, path, handler)
+	return s.add(method, path, getHandlerName(handler))
+}
+
+func (s *httprouterSwagger) HandlerFunc(method, path string, handler http.HandlerFunc) models.Swagger {
+	return s.Handler(method, path, handler)
+}
+
+// Any registers the handle for the methods of generator.AnyMethods,
+// httprouter has no route matching every method.
+func (s *httprouterSwagger) Any(path string, handle httprouter.Handle) models.MultiSwagger {
+	return s.Match(generator.AnyMethods, path, handle)
+}
+
+func (s *httprouterSwagger) Match(methods []string, path string, handle httprouter.Handle) models.MultiSwagger {
+	operations := make(httprouterOperations, 0, len(methods))
+	for _, method := range methods {
+		method = strings.ToUpper(method)
+		s.router.Handle(method, path, handle)
+		operations = append(operations, s.add(method, path, getFuncName(handle)))
+	}
+
+	return operations
+}
+
+func (s *httprouterSwagger) ServeFiles(path string, root http.FileSystem) {
+	s.router.ServeFiles(path, root)
+}
+
+func (s *httprouterSwagger) GET(path string, handle httprouter.Handle) models.Swagger {
+	return s.Handle(http.MethodGet, path, handle)
+}
+
+func (s *httprouterSwagger) POST(path string, handle httprouter.Handle) models.Swagger {
+	return s.Handle(http.MethodPost, path, handle)
+}
+
+func (s *httprouterSwagger) PUT(path string, handle httprouter.Handle) models.Swagger {
+	return s.Handle(http.MethodPut, path, handle)
+}
+
+func (s *httprouterSwagger) DELETE(path string, handle httprouter.Handle) models.Swagger {
+	return s.Handle(http.MethodDelete, path, handle)
+}
+
+func (s *httprouterSwagger) PATCH(path string, handle httprouter.Handle) models.Swagger {
+	return s.Handle(http.MethodPatch, path, handle)
+}
+
+func (s *httprouterSwagger) OPTIONS(path string, handle httprouter.Handle) models.Swagger {
+	return s.Handle(http.MethodOptions, path, handle)
+}
+
+func (s *httprouterSwagger) HEAD(path string, handle httprouter.Handle) models.Swagger {
+	return s.Handle(http.MethodHead, path, handle)
+}
+
+type httprouterRoute struct {
+	Route generator.Route
+	// path is the path with the httprouter syntax, used to match the requests
+	path string
+}
+
+func (r *httprouterRoute) Summary(summary string) models.Swagger {
+	r.Route.Summary = summary
+	return r
+}
+
+func (r *httprouterRoute) Description(description string) models.Swagger {
+	r.Route.Description = description
+	return r
+}
+
+func (r *httprouterRoute) Tags(tags ...string) models.Swagger {
+	r.Route.Tags = tags
+	return r
+}
+
+func (r *httprouterRoute) Accepts(accepts ...string) models.Swagger {
+	r.Route.Accepts = accepts
+	return r
+}
+
+func (r *httprouterRoute) Produces(produces ...string) models.Swagger {
+	r.Route.Produces = produces
+	return r
+}
+
+func (r *httprouterRoute) Read(reads interface{}) models.Swagger {
+	r.Route.Reads = reads
+	return r
+}
+
+func (r *httprouterRoute) ReadFieldDescriptions(descriptions map[string]string) models.Swagger {
+	r.Route.ReadFieldDescriptions = descriptions
+	return r
+}
+
+func (r *httprouterRoute) Returns(returns []models.ReturnType) models.Swagger {
+	r.Route.Returns = returns
+	return r
+}
+
+func (r *httprouterRoute) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.QueryParams = append(r.Route.QueryParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *httprouterRoute) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.HeaderParams = append(r.Route.HeaderParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+// PathParam refines the parameter declared from the path variable with the same name.
+func (r *httprouterRoute) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.PathParams = generator.SetParam(r.Route.PathParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *httprouterRoute) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.CookieParams = append(r.Route.CookieParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *httprouterRoute) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, paramType, required, opts...))
+	return r
+}
+
+func (r *httprouterRoute) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	r.Route.FormParams = append(r.Route.FormParams, generator.NewParam(name, description, "file", required, opts...))
+	return r
+}
+
+// Params declares the query, header and path parameters read from the tags of the fields of a struct.
+func (r *httprouterRoute) Params(v any) models.Swagger {
+	path, query, header := generator.StructParams(v, r.Route.Method)
+	r.Route.PathParams = generator.SetParam(r.Route.PathParams, path...)
+	r.Route.QueryParams = generator.SetParam(r.Route.QueryParams, query...)
+	r.Route.HeaderParams = generator.SetParam(r.Route.HeaderParams, header...)
+
+	return r
+}
+
+func (r *httprouterRoute) Security(schemes ...string) models.Swagger {
+	r.Route.Security = append(r.Route.Security, schemes...)
+	return r
+}
+
+// httprouterOperations applies the annotations to the routes of all the methods of a path.
+type httprouterOperations []*httprouterRoute
+
+func (ops httprouterOperations) each(fn func(r *httprouterRoute)) models.Swagger {
+	for _, r := range ops {
+		fn(r)
+	}
+
+	return ops
+}
+
+// Method returns the route of the method, or a detached one that is not documented.
+func (ops httprouterOperations) Method(method string) models.Swagger {
+	for _, r := range ops {
+		if strings.EqualFold(r.Route.Method, method) {
+			return r
+		}
+	}
+
+	return &httprouterRoute{}
+}
+
+func (ops httprouterOperations) Summary(summary string) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.Summary(summary) })
+}
+
+func (ops httprouterOperations) Description(description string) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.Description(description) })
+}
+
+func (ops httprouterOperations) Tags(tags ...string) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.Tags(tags...) })
+}
+
+func (ops httprouterOperations) Accepts(accepts ...string) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.Accepts(accepts...) })
+}
+
+func (ops httprouterOperations) Produces(produces ...string) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.Produces(produces...) })
+}
+
+func (ops httprouterOperations) Read(reads interface{}) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.Read(reads) })
+}
+
+func (ops httprouterOperations) ReadFieldDescriptions(descriptions map[string]string) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.ReadFieldDescriptions(descriptions) })
+}
+
+func (ops httprouterOperations) Returns(returns []models.ReturnType) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.Returns(returns) })
+}
+
+func (ops httprouterOperations) QueryParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.QueryParam(name, description, paramType, required, opts...) })
+}
+
+func (ops httprouterOperations) HeaderParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.HeaderParam(name, description, paramType, required, opts...) })
+}
+
+func (ops httprouterOperations) PathParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.PathParam(name, description, paramType, required, opts...) })
+}
+
+func (ops httprouterOperations) CookieParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.CookieParam(name, description, paramType, required, opts...) })
+}
+
+func (ops httprouterOperations) FormParam(name, description, paramType string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.FormParam(name, description, paramType, required, opts...) })
+}
+
+func (ops httprouterOperations) FileParam(name, description string, required bool, opts ...models.ParamOption) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.FileParam(name, description, required, opts...) })
+}
+
+func (ops httprouterOperations) Params(v any) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.Params(v) })
+}
+
+func (ops httprouterOperations) Security(schemes ...string) models.Swagger {
+	return ops.each(func(r *httprouterRoute) { r.Security(schemes...) })
+}
@@ -0,0 +1,206 @@
+package httprouter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func handleGetUser(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	_, _ = w.Write([]byte(ps.ByName("id")))
+}
+
+func handleHealth(w http.ResponseWriter, r *http.Request) {}
+
+type statusHandler struct{}
+
+func (statusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {}
+
+func TestNewHTTPRouter(t *testing.T) {
+	router := httprouter.New()
+	s := NewHTTPRouter(router)
+
+	assert.Equal(t, router, s.Router())
+}
+
+func TestHTTPRouterSwagger_routes(t *testing.T) {
+	s := NewHTTPRouter(httprouter.New())
+
+	s.GET("/users/:id", handleGetUser).Summary("Get user")
+	s.DELETE("/users/:id", handleGetUser)
+	s.Handle(http.MethodPost, "/users", handleGetUser)
+	s.HandlerFunc(http.MethodGet, "/health", handleHealth)
+	s.Handler(http.MethodPatch, "/status", statusHandler{})
+	s.GET("/files/*filepath", handleGetUser)
+
+	assert.Equal(t, []generator.Route{
+		{Path: "/users/{id}", Method: http.MethodGet, FuncName: "handleGetUser", Summary: "Get user", PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}}},
+		{Path: "/users/{id}", Method: http.MethodDelete, FuncName: "handleGetUser", PathParams: []generator.Param{{Name: "id", ParamType: "string", Required: true}}},
+		{Path: "/users", Method: http.MethodPost, FuncName: "handleGetUser"},
+		{Path: "/health", Method: http.MethodGet, FuncName: "handleHealth"},
+		{Path: "/status", Method: http.MethodPatch, FuncName: "statusHandler"},
+		{Path: "/files/{filepath}", Method: http.MethodGet, FuncName: "handleGetUser", PathParams: []generator.Param{{Name: "filepath", ParamType: "string", Required: true}}},
+	}, toGoSwagRoute(s.routes))
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users/42", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "42", w.Body.String())
+}
+
+func TestToSwagPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/users", want: "/users"},
+		{path: "/users/:id", want: "/users/{id}"},
+		{path: "/users/:id/posts/:post", want: "/users/{id}/posts/{post}"},
+		{path: "/src/*filepath", want: "/src/{filepath}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, toSwagPath(tt.path))
+		})
+	}
+}
+
+func TestHTTPRouterSwagger_OpenAPI(t *testing.T) {
+	s := NewHTTPRouter(httprouter.New())
+	s.GET("/users/:id", handleGetUser).PathParam("id", "user id", "int", true)
+
+	content, err := s.OpenAPI()
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), `"/users/{id}"`)
+}
+
+func TestHTTPRouterSwagger_ServeDocs(t *testing.T) {
+	s := NewHTTPRouter(httprouter.New())
+	s.ServeDocs("/docs")
+	s.GET("/users/:id", handleGetUser).Summary("Get user")
+
+	for path, contains := range map[string]string{
+		"/docs":              "swagger-ui",
+		"/docs/redoc":        "redoc",
+		"/docs/openapi.json": `"/users/{id}"`,
+		"/docs/openapi.yaml": "summary: Get user",
+	} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code, path)
+		assert.Contains(t, w.Body.String(), contains, path)
+	}
+}
+
+func TestHTTPRouterSwagger_ServeDocsRoot(t *testing.T) {
+	s := NewHTTPRouter(httprouter.New())
+	s.ServeDocs("/")
+	s.GET("/users/:id", handleGetUser)
+
+	// the other routes are still reachable next to the docs served at the root
+	for path, contains := range map[string]string{
+		"/":             "swagger-ui",
+		"/redoc":        "redoc",
+		"/openapi.json": `"/users/{id}"`,
+		"/users/1":      "",
+	} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code, path)
+		assert.Contains(t, w.Body.String(), contains, path)
+	}
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/unknown", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHTTPRouterSwagger_ValidationMiddleware(t *testing.T) {
+	s := NewHTTPRouter(httprouter.New())
+	s.GET("/users/:id", handleGetUser).
+		PathParam("id", "user id", "int", true).
+		QueryParam("verbose", "verbose output", "bool", false)
+	s.GET("/files/*filepath", handleGetUser).PathParam("filepath", "file path", "string", true)
+	handler := s.ValidationMiddleware()(s)
+
+	for path, want := range map[string]int{
+		"/users/1":             http.StatusOK,
+		"/users/a":             http.StatusBadRequest,
+		"/users/1?verbose=yes": http.StatusBadRequest,
+		"/files/a/b":           http.StatusOK,
+		"/items/1":             http.StatusNotFound,
+	} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, want, w.Code, path)
+	}
+}
+
+func TestHTTPRouterRoute(t *testing.T) {
+	r := &httprouterRoute{}
+	r.Summary("summary").
+		Description("description").
+		Tags("users").
+		Accepts("json").
+		Produces("xml").
+		Read(struct{}{}).
+		ReadFieldDescriptions(map[string]string{"name": "Name"}).
+		Returns([]models.ReturnType{{StatusCode: http.StatusOK}}).
+		QueryParam("q", "query", "string", false).
+		HeaderParam("h", "header", "string", true).
+		PathParam("id", "id", "int", true).
+		Security("BearerAuth")
+
+	assert.Equal(t, generator.Route{
+		Summary:               "summary",
+		Description:           "description",
+		Tags:                  []string{"users"},
+		Accepts:               []string{"json"},
+		Produces:              []string{"xml"},
+		Reads:                 struct{}{},
+		ReadFieldDescriptions: map[string]string{"name": "Name"},
+		Returns:               []models.ReturnType{{StatusCode: http.StatusOK}},
+		QueryParams:           []generator.Param{{Name: "q", Description: "query", ParamType: "string"}},
+		HeaderParams:          []generator.Param{{Name: "h", Description: "header", ParamType: "string", Required: true}},
+		PathParams:            []generator.Param{{Name: "id", Description: "id", ParamType: "int", Required: true}},
+		Security:              []string{"BearerAuth"},
+	}, r.Route)
+}
+
+func TestHTTPRouterSwagger_AnyMatch(t *testing.T) {
+	s := NewHTTPRouter(httprouter.New())
+
+	m := s.Match([]string{"get", http.MethodHead}, "/files/:id", handleGetUser)
+	m.Summary("Get file").Tags("files")
+	m.Method(http.MethodHead).Summary("Check file")
+	m.Method(http.MethodPost).Summary("not documented")
+	s.Any("/echo", handleGetUser).Summary("Echo")
+
+	routes := toGoSwagRoute(s.routes)
+	idParam := generator.Param{Name: "id", ParamType: "string", Required: true}
+	assert.Equal(t, []generator.Route{
+		{Path: "/files/{id}", Method: http.MethodGet, FuncName: "handleGetUser", Summary: "Get file", Tags: []string{"files"}, PathParams: []generator.Param{idParam}},
+		{Path: "/files/{id}", Method: http.MethodHead, FuncName: "handleGetUser", Summary: "Check file", Tags: []string{"files"}, PathParams: []generator.Param{idParam}},
+	}, routes[:2])
+
+	assert.Len(t, routes[2:], len(generator.AnyMethods))
+	for i, r := range routes[2:] {
+		assert.Equal(t, generator.Route{Path: "/echo", Method: generator.AnyMethods[i], FuncName: "handleGetUser", Summary: "Echo"}, r)
+	}
+
+	for method, want := range map[string]int{http.MethodGet: http.StatusOK, http.MethodPost: http.StatusMethodNotAllowed} {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(method, "/files/42", nil))
+		assert.Equal(t, want, w.Code, method)
+	}
+}
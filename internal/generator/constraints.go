@@ -0,0 +1,174 @@
+package generator
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ruleFormats are the formats of the string rules of the validation tags.
+var ruleFormats = map[string]string{
+	"email": "email",
+	"url":   "uri",
+	"uri":   "uri",
+	"uuid":  "uuid",
+	"ipv4":  "ipv4",
+	"ipv6":  "ipv6",
+}
+
+// validationRules returns the rules of the binding tag read by gin and of the validate tag
+// read by go-playground/validator, e.g. binding:"required,min=1,max=100".
+func validationRules(field reflect.StructField) []string {
+	var rules []string
+	for _, key := range []string{"binding", "validate"} {
+		tag := field.Tag.Get(key)
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		for _, rule := range strings.Split(tag, ",") {
+			if rule = strings.TrimSpace(rule); rule != "" {
+				rules = append(rules, rule)
+			}
+		}
+	}
+
+	return rules
+}
+
+// applyRules sets the constraints of the validation rules on the schema of a value of type t and
+// reports if the value is required. The rules after dive apply to the items of slices and the values
+// of maps. Referenced schemas are not changed, the rules combined with | are ignored.
+func applyRules(schema *Schema, t reflect.Type, rules []string) (required bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	for i, rule := range rules {
+		name, param, _ := strings.Cut(rule, "=")
+		if name == "required" {
+			required = true
+			continue
+		}
+
+		if schema == nil || schema.Ref != "" || strings.Contains(rule, "|") {
+			continue
+		}
+
+		switch name {
+		case "dive":
+			switch t.Kind() {
+			case reflect.Slice, reflect.Array:
+				applyRules(schema.Items, t.Elem(), rules[i+1:])
+			case reflect.Map:
+				values := rules[i+1:]
+				if len(values) > 0 && values[0] == "keys" {
+					// the rules of the keys can not be described
+					for len(values) > 0 && values[0] != "endkeys" {
+						values = values[1:]
+					}
+
+					if len(values) > 0 {
+						values = values[1:]
+					}
+				}
+
+				applyRules(schema.AdditionalProperties, t.Elem(), values)
+			}
+
+			return required
+		case "min", "gte":
+			setBound(schema, t, param, true)
+		case "max", "lte":
+			setBound(schema, t, param, false)
+		case "len":
+			setBound(schema, t, param, true)
+			setBound(schema, t, param, false)
+		case "oneof":
+			schema.Enum = nil
+			for _, value := range oneOfValues(param) {
+				schema.Enum = append(schema.Enum, enumValue(schema, value))
+			}
+		default:
+			if format, ok := ruleFormats[name]; ok && schema.Type == "string" {
+				schema.Format = format
+			}
+		}
+	}
+
+	return required
+}
+
+// setBound sets the lower or upper bound of the rule parameter, which limits the value of numbers,
+// the number of characters of strings and the number of items of slices.
+func setBound(schema *Schema, t reflect.Type, param string, lower bool) {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return
+		}
+
+		if lower {
+			schema.Minimum = &f
+		} else {
+			schema.Maximum = &f
+		}
+	case reflect.String, reflect.Slice, reflect.Array:
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return
+		}
+
+		switch {
+		case schema.Type == "array" && lower:
+			schema.MinItems = &n
+		case schema.Type == "array":
+			schema.MaxItems = &n
+		case schema.Type == "string" && t.Kind() == reflect.String && lower:
+			schema.MinLength = &n
+		case schema.Type == "string" && t.Kind() == reflect.String:
+			schema.MaxLength = &n
+		}
+	}
+}
+
+// oneOfValues splits the values of the oneof rule, separated by spaces or quoted like 'a value'.
+func oneOfValues(param string) []string {
+	var (
+		values []string
+		quoted bool
+		value  strings.Builder
+	)
+
+	for _, r := range param {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case r == ' ' && !quoted:
+			if value.Len() > 0 {
+				values = append(values, value.String())
+				value.Reset()
+			}
+		default:
+			value.WriteRune(r)
+		}
+	}
+
+	if value.Len() > 0 {
+		values = append(values, value.String())
+	}
+
+	return values
+}
+
+// enumValue converts the value to the type of the schema, it is kept as a string when it does not match.
+func enumValue(schema *Schema, value string) any {
+	if v, err := paramValue(schema.Type, value); err == nil {
+		return v
+	}
+
+	return value
+}
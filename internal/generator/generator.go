@@ -1,12 +1,18 @@
 package generator
 
 import (
+	"bytes"
 	"fmt"
+	"go/format"
+	"go/token"
 	"io"
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
 	"reflect"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/r0bertson/goswag/models"
@@ -14,11 +20,29 @@ import (
 
 const fileName = "goswag.go"
 
+// Param is a query, header or path parameter, the constraints are described by models.ParamOptions.
 type Param struct {
 	Name        string
 	Description string
 	ParamType   string
 	Required    bool
+	// Pattern is a regular expression the value must match, swag does not support it in the comments.
+	Pattern string
+	// Default is the value used when the parameter is not sent.
+	Default string
+	// Enum are the values accepted by the parameter.
+	Enum             []string
+	Example          string
+	Format           string
+	Minimum          *float64
+	Maximum          *float64
+	MinLength        *int
+	MaxLength        *int
+	Array            bool
+	CollectionFormat string
+	Deprecated       bool
+	MimeTypes        []string
+	MaxSize          int64
 }
 
 type Route struct {
@@ -35,10 +59,40 @@ type Route struct {
 	// The key should be the JSON field name (e.g., "id", "name", "email").
 	ReadFieldDescriptions map[string]string
 	Returns               []models.ReturnType // example: map[statusCode]responseBody
-	QueryParams           []Param
-	HeaderParams          []Param
-	PathParams            []Param
-	Security              []string
+	// Success is the response inferred from the signature of a typed handler,
+	// it is documented before Returns when they have no successful response.
+	Success      *models.ReturnType
+	QueryParams  []Param
+	HeaderParams []Param
+	PathParams   []Param
+	CookieParams []Param
+	FormParams   []Param
+	Security     []string
+}
+
+// responses returns the responses of the route, with Success first when Returns has no 2xx response.
+func (r Route) responses() []models.ReturnType {
+	if r.Success == nil {
+		return r.Returns
+	}
+
+	for _, ret := range r.Returns {
+		if ret.StatusCode >= 200 && ret.StatusCode < 300 {
+			return r.Returns
+		}
+	}
+
+	return append([]models.ReturnType{*r.Success}, r.Returns...)
+}
+
+// body returns the request body read by the route. The form parameters are the body of the route,
+// so Reads is left out of the documents when both are set, which the validation reports.
+func (r Route) body() interface{} {
+	if len(r.FormParams) > 0 {
+		return nil
+	}
+
+	return r.Reads
 }
 
 type Group struct {
@@ -47,35 +101,155 @@ type Group struct {
 	Groups    []Group
 }
 
-func GenerateSwagger(routes []Route, groups []Group, defaultResponses []models.ReturnType) {
+// GenerateSwagger writes the goswag.go file and stops the program if it can not be written.
+// The invalid annotations are logged as warnings, Generate rejects them.
+func GenerateSwagger(routes []Route, groups []Group, defaultResponses []models.ReturnType, info *models.Info) {
+	generateLenient(routes, groups, defaultResponses, models.GenerateOptions{Logger: log.Default(), Info: info})
+}
+
+// Generate validates the routes and writes the documentation in the format defined by the options.
+// Invalid annotations, unsupported body types, invalid options and format or write failures are returned as
+// *models.AnnotationError, *models.UnsupportedTypeError, *models.OptionsError and *models.OutputError.
+func Generate(routes []Route, groups []Group, defaultResponses []models.ReturnType, opts models.GenerateOptions) error {
+	if err := validate(routes, groups, defaultResponses, opts); err != nil {
+		return err
+	}
+
+	return generate(routes, groups, defaultResponses, opts)
+}
+
+// generateLenient writes the documentation like Generate, but only logs the problems of the annotations,
+// so the programs written before the validation existed keep working. It stops the program if the
+// documentation can not be written.
+func generateLenient(routes []Route, groups []Group, defaultResponses []models.ReturnType, opts models.GenerateOptions) {
+	for _, err := range joinedErrors(validate(routes, groups, defaultResponses, opts)) {
+		logf(opts, "warning: %v", err)
+	}
+
+	if err := generate(routes, groups, defaultResponses, opts); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// joinedErrors returns the errors joined with errors.Join, nested ones included.
+func joinedErrors(err error) []error {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		if err == nil {
+			return nil
+		}
+
+		return []error{err}
+	}
+
+	var errs []error
+	for _, e := range joined.Unwrap() {
+		errs = append(errs, joinedErrors(e)...)
+	}
+
+	return errs
+}
+
+func generate(routes []Route, groups []Group, defaultResponses []models.ReturnType, opts models.GenerateOptions) error {
+	switch opts.Format {
+	case models.FormatOpenAPI:
+		return generateOpenAPI(routes, groups, defaultResponses, opts)
+	case models.FormatSwagger2JSON, models.FormatSwagger2YAML:
+		return generateSwagger2(routes, groups, defaultResponses, opts)
+	default:
+		return generateSwag(routes, groups, defaultResponses, opts)
+	}
+}
+
+func generateSwag(routes []Route, groups []Group, defaultResponses []models.ReturnType, opts models.GenerateOptions) error {
 	var (
 		packagesToImport = make(map[string]bool)
 		fullFileContent  = &strings.Builder{}
 		wrapperStructs   = &strings.Builder{} // Store wrapper structs with descriptions
 	)
 
-	log.Printf("Generating %s file...", fileName)
+	packageName := opts.PackageName
+	if packageName == "" {
+		packageName = "main"
+	}
+
+	filePath := outputPath(opts, fileName)
+	if !token.IsIdentifier(packageName) {
+		return &models.OptionsError{Option: "PackageName", Reason: fmt.Sprintf("%q is not an identifier", packageName)}
+	}
+
+	logf(opts, "Generating %s file...", filePath)
 
 	routes, groups = addDefaultResponses(routes, groups, defaultResponses)
+	uniqueFuncNames(routes, groups, operationIDs{})
 
 	if routes != nil {
 		writeRoutes("", routes, fullFileContent, packagesToImport, wrapperStructs)
 	}
 
 	if groups != nil {
-		writeGroup(groups, fullFileContent, packagesToImport, wrapperStructs)
+		writeGroup(nil, groups, fullFileContent, packagesToImport, wrapperStructs)
 	}
 
-	f, err := os.Create(fmt.Sprintf("./%s", fileName))
+	// Write wrapper structs first, then the rest of the content
+	file := &bytes.Buffer{}
+	writeGeneralInfo(file, opts.Info)
+	writeFileContent(file, packageName, wrapperStructs.String()+fullFileContent.String(), packagesToImport)
+
+	content, err := format.Source(file.Bytes())
 	if err != nil {
-		log.Fatal(err)
+		return &models.OutputError{Path: filePath, Err: fmt.Errorf("formatting: %w", err)}
 	}
-	defer f.Close()
 
-	// Write wrapper structs first, then the rest of the content
-	writeFileContent(f, wrapperStructs.String()+fullFileContent.String(), packagesToImport)
+	if err := writeOutput(filePath, content, opts); err != nil {
+		return err
+	}
 
-	log.Printf("%s file generated successfully!", fileName)
+	logf(opts, "%s file generated successfully!", filePath)
+
+	return nil
+}
+
+// outputPath returns the path of the generated file based on the options.
+func outputPath(opts models.GenerateOptions, defaultFileName string) string {
+	name := opts.FileName
+	if name == "" {
+		name = defaultFileName
+	}
+
+	dir := opts.OutputDir
+	if dir == "" {
+		dir = "."
+	}
+
+	return filepath.Join(dir, name)
+}
+
+// writeOutput writes the content to the writer of the options or, if it is not set, to the file path.
+func writeOutput(filePath string, content []byte, opts models.GenerateOptions) error {
+	if opts.Writer != nil {
+		if _, err := opts.Writer.Write(content); err != nil {
+			return &models.OutputError{Path: filePath, Err: err}
+		}
+
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return &models.OutputError{Path: filePath, Err: err}
+	}
+
+	if err := os.WriteFile(filePath, content, 0o644); err != nil {
+		return &models.OutputError{Path: filePath, Err: err}
+	}
+
+	return nil
+}
+
+func logf(opts models.GenerateOptions, format string, v ...interface{}) {
+	if opts.Logger != nil {
+		opts.Logger.Printf(format, v...)
+	}
 }
 
 // addDefaultResponses adds the default responses to the routes and groups if it are not empty
@@ -85,7 +259,9 @@ func addDefaultResponses(routes []Route, groups []Group, defaultResponses []mode
 	}
 
 	for i := range routes {
-		routes[i].Returns = append(routes[i].Returns, defaultResponses...)
+		// the inferred success is merged first, so a default 2xx response does not replace it
+		routes[i].Returns = append(routes[i].responses(), defaultResponses...)
+		routes[i].Success = nil
 	}
 
 	for i := range groups {
@@ -95,13 +271,49 @@ func addDefaultResponses(routes []Route, groups []Group, defaultResponses []mode
 	return routes, groups
 }
 
-func writeFileContent(file io.Writer, content string, packagesToImport map[string]bool) {
-	fmt.Fprintf(file, "package main\n\n")
+// uniqueFuncNames numbers the repeated function names, a handler registered for several
+// paths or methods would otherwise be declared several times in the goswag.go file.
+func uniqueFuncNames(routes []Route, groups []Group, ids operationIDs) {
+	for i := range routes {
+		routes[i].FuncName = ids.next(routeFuncName(routes[i]))
+	}
+
+	for i := range groups {
+		uniqueFuncNames(groups[i].Routes, groups[i].Groups, ids)
+	}
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// routeFuncName returns the function name of the handler of the route when it is a valid identifier.
+// The other handlers, like the nested closures the runtime names "1", are named after the
+// method and the path, e.g. getUsersId for GET /users/{id}.
+func routeFuncName(r Route) string {
+	if r.FuncName == "" || token.IsIdentifier(r.FuncName) {
+		return r.FuncName
+	}
+
+	name := strings.ToLower(r.Method)
+	for _, word := range nonAlphanumeric.Split(r.Path, -1) {
+		if word != "" {
+			name += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	if !token.IsIdentifier(name) {
+		return "handler" + name
+	}
+
+	return name
+}
+
+func writeFileContent(file io.Writer, packageName, content string, packagesToImport map[string]bool) {
+	fmt.Fprintf(file, "package %s\n\n", packageName)
 
 	if len(packagesToImport) > 0 {
 		fmt.Fprintf(file, "import (\n")
 
-		for pkg := range packagesToImport {
+		for _, pkg := range sortedKeys(packagesToImport) {
 			fmt.Fprintf(file, "\t_ \"%s\"\n", pkg)
 		}
 
@@ -111,53 +323,51 @@ func writeFileContent(file io.Writer, content string, packagesToImport map[strin
 	fmt.Fprintf(file, "%s", content)
 }
 
-func writeRoutes(groupName string, routes []Route, s *strings.Builder, packagesToImport map[string]bool, wrapperStructs *strings.Builder) {
+// writeRoutes writes the comments of the routes, groupTags are the comma separated tags of their groups.
+func writeRoutes(groupTags string, routes []Route, s *strings.Builder, packagesToImport map[string]bool, wrapperStructs *strings.Builder) {
 	for _, r := range routes {
 		addLineIfNotEmpty(s, r.Summary, "// @Summary %s\n")
 		addTextIfNotEmptyOrDefault(s, r.Summary, "// @Description %s\n", r.Description)
 
 		if len(r.Tags) > 0 {
-			s.WriteString(fmt.Sprintf("// @Tags %s\n", strings.Join(r.Tags, ",")))
-		} else if groupName != "" {
-			s.WriteString(fmt.Sprintf("// @Tags %s\n", groupName))
+			s.WriteString(fmt.Sprintf("// @Tags %s\n", singleLine(strings.Join(r.Tags, ","))))
+		} else if groupTags != "" {
+			s.WriteString(fmt.Sprintf("// @Tags %s\n", singleLine(groupTags)))
 		}
 
-		if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		if r.Method == http.MethodPost || r.Method == http.MethodPut || len(r.FormParams) > 0 {
 			// methods like get or delete do not have a request body
-			addTextIfNotEmptyOrDefault(s, "json", "// @Accept %s\n", r.Accepts...)
+			addTextIfNotEmptyOrDefault(s, "json", "// @Accept %s\n", routeAccepts(r)...)
 		}
 
-		if r.Returns != nil {
+		returns := r.responses()
+		if returns != nil {
 			// only add the produces if there is a return
 			addTextIfNotEmptyOrDefault(s, "json", "// @Produce %s\n", r.Produces...)
 		}
 
-		if r.Reads != nil {
-			structName := getStructAndPackageName(r.Reads)
+		if body := r.body(); body != nil {
+			structName := getStructAndPackageName(body)
 			// If field descriptions are provided, generate a wrapper struct
 			if len(r.ReadFieldDescriptions) > 0 {
-				wrapperName := generateWrapperStruct(r.Reads, r.ReadFieldDescriptions, wrapperStructs, packagesToImport, "Request")
+				wrapperName := generateWrapperStruct(body, r.ReadFieldDescriptions, wrapperStructs, packagesToImport, "Request")
 				structName = wrapperName
 			}
 			s.WriteString(fmt.Sprintf("// @Param request body %s true \"Request\"\n", structName))
 		}
 
-		for _, param := range r.PathParams {
-			s.WriteString(fmt.Sprintf("// @Param %s path %s %t \"%s\"\n",
-				param.Name, param.ParamType, param.Required, param.Description),
-			)
-		}
-
-		for _, param := range r.QueryParams {
-			s.WriteString(fmt.Sprintf("// @Param %s query %s %t \"%s\"\n",
-				param.Name, param.ParamType, param.Required, param.Description),
-			)
-		}
-
-		for _, param := range r.HeaderParams {
-			s.WriteString(fmt.Sprintf("// @Param %s header %s %t \"%s\"\n",
-				param.Name, param.ParamType, param.Required, param.Description),
-			)
+		for _, params := range []struct {
+			in   string
+			list []Param
+		}{
+			{"path", r.PathParams}, {"query", r.QueryParams}, {"header", r.HeaderParams},
+			{"cookie", r.CookieParams}, {"formData", r.FormParams},
+		} {
+			for _, param := range params.list {
+				s.WriteString(fmt.Sprintf("// @Param %s %s %s %t \"%s\"%s\n",
+					param.Name, params.in, swagParamType(param), param.Required, singleLine(paramDescription(param)), singleLine(paramAttributes(param))),
+				)
+			}
 		}
 
 		if len(r.Security) > 0 {
@@ -165,12 +375,12 @@ func writeRoutes(groupName string, routes []Route, s *strings.Builder, packagesT
 				if strings.TrimSpace(scheme) == "" { // skip empty
 					continue
 				}
-				s.WriteString(fmt.Sprintf("// @Security %s\n", scheme))
+				s.WriteString(fmt.Sprintf("// @Security %s\n", singleLine(scheme)))
 			}
 		}
 
-		if r.Returns != nil {
-			writeReturns(r.Returns, s, packagesToImport, wrapperStructs)
+		if returns != nil {
+			writeReturns(returns, s, packagesToImport, wrapperStructs)
 		}
 
 		if r.Path != "" {
@@ -224,16 +434,28 @@ func writeReturns(returns []models.ReturnType, s *strings.Builder, packagesToImp
 	}
 }
 
-func writeGroup(groups []Group, s *strings.Builder, packagesToImport map[string]bool, wrapperStructs *strings.Builder) {
+// writeGroup writes the routes of the groups tagged with the name of their innermost named group.
+func writeGroup(parentTags []string, groups []Group, s *strings.Builder, packagesToImport map[string]bool, wrapperStructs *strings.Builder) {
 	for _, g := range groups {
-		writeRoutes(g.GroupName, g.Routes, s, packagesToImport, wrapperStructs)
+		tags := groupTags(parentTags, g)
+		writeRoutes(strings.Join(tags, ","), g.Routes, s, packagesToImport, wrapperStructs)
 
 		if g.Groups != nil {
-			writeGroup(g.Groups, s, packagesToImport, wrapperStructs)
+			writeGroup(tags, g.Groups, s, packagesToImport, wrapperStructs)
 		}
 	}
 }
 
+// groupTags returns the tags of the routes of the group: its name, or the tags of its parent
+// when it has none, so the routes are tagged by their innermost named group.
+func groupTags(parentTags []string, g Group) []string {
+	if g.GroupName == "" {
+		return parentTags
+	}
+
+	return []string{g.GroupName}
+}
+
 // addPackageToImport adds the package to import.
 func addPackageToImport(data models.ReturnType, packagesToImport map[string]bool) {
 	if data.Body == nil {
@@ -310,22 +532,27 @@ func writeIfIsGenericType(s *strings.Builder, data models.ReturnType, respType s
 }
 
 func handleOverrideStructFields(s *strings.Builder, data models.ReturnType) {
-	if data.OverrideStructFields != nil {
-		i := 0
-		for key, object := range data.OverrideStructFields {
-			if i == 0 {
-				s.WriteString("{")
-			}
+	if len(data.OverrideStructFields) == 0 {
+		return
+	}
 
-			s.WriteString(fmt.Sprintf("%s=%s", key, getStructAndPackageName(object)))
-			if i == len(data.OverrideStructFields)-1 {
-				s.WriteString("}")
-			} else {
-				s.WriteString(",")
-			}
-			i++
-		}
+	// the fields are sorted to generate the same output on every run
+	fields := make([]string, 0, len(data.OverrideStructFields))
+	for _, key := range sortedKeys(data.OverrideStructFields) {
+		fields = append(fields, fmt.Sprintf("%s=%s", key, getStructAndPackageName(data.OverrideStructFields[key])))
 	}
+
+	s.WriteString(fmt.Sprintf("{%s}", strings.Join(fields, ",")))
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
 }
 
 // generateWrapperStruct generates a wrapper struct with field descriptions as comments.
@@ -346,14 +573,8 @@ func generateWrapperStruct(originalStruct interface{}, fieldDescriptions map[str
 	}
 
 	// Create a unique wrapper name
-	wrapperName := fmt.Sprintf("Wrapper%s%s", sanitizeStructName(originalName), suffix)
-
-	// Check if we've already generated this wrapper (avoid duplicates)
-	// For now, we'll generate it each time - could optimize later with a map
-
-	// Write the wrapper struct definition
-	wrapperStructs.WriteString(fmt.Sprintf("// %s is a wrapper struct with field descriptions\n", wrapperName))
-	wrapperStructs.WriteString(fmt.Sprintf("type %s struct {\n", wrapperName))
+	baseName := fmt.Sprintf("Wrapper%s%s", sanitizeStructName(originalName), suffix)
+	fields := &strings.Builder{}
 
 	// Iterate through struct fields
 	for i := 0; i < t.NumField(); i++ {
@@ -372,7 +593,7 @@ func generateWrapperStruct(originalStruct interface{}, fieldDescriptions map[str
 
 		// Add description comment if available
 		if desc, ok := fieldDescriptions[jsonName]; ok {
-			wrapperStructs.WriteString(fmt.Sprintf("\t// %s\n", desc))
+			fields.WriteString(fmt.Sprintf("\t// %s\n", singleLine(desc)))
 		}
 
 		// Handle pointer fields - ensure they're marked as optional/nullable
@@ -385,10 +606,26 @@ func generateWrapperStruct(originalStruct interface{}, fieldDescriptions map[str
 		}
 
 		// Write field definition
-		wrapperStructs.WriteString(fmt.Sprintf("\t%s %s `%s`\n", field.Name, fieldType, updatedTag))
+		fields.WriteString(fmt.Sprintf("\t%s %s `%s`\n", field.Name, fieldType, updatedTag))
 	}
 
-	wrapperStructs.WriteString("}\n\n")
+	// The same struct can be used by many routes, the wrapper is written only once and
+	// a numeric suffix is added when the same struct has different descriptions.
+	wrapperName := baseName
+	for i := 2; ; i++ {
+		definition := fmt.Sprintf("type %s struct {\n%s}\n\n", wrapperName, fields.String())
+		if strings.Contains(wrapperStructs.String(), definition) {
+			break
+		}
+
+		if !strings.Contains(wrapperStructs.String(), fmt.Sprintf("type %s struct {\n", wrapperName)) {
+			wrapperStructs.WriteString(fmt.Sprintf("// %s is a wrapper struct with field descriptions\n", wrapperName))
+			wrapperStructs.WriteString(definition)
+			break
+		}
+
+		wrapperName = fmt.Sprintf("%s%d", baseName, i)
+	}
 
 	// Add package to imports
 	if t.PkgPath() != "" {
@@ -491,18 +728,38 @@ func getStructAndPackageName(body any) string {
 func addTextIfNotEmptyOrDefault(s *strings.Builder, defaultText, format string, text ...string) {
 	if text != nil {
 		if len(text) >= 1 && strings.TrimSpace(text[0]) != "" {
-			s.WriteString(fmt.Sprintf(format, strings.Join(text, ",")))
+			s.WriteString(fmt.Sprintf(format, singleLine(strings.Join(text, ","))))
 			return
 		}
 	}
 
 	if defaultText != "" {
-		s.WriteString(fmt.Sprintf(format, defaultText))
+		s.WriteString(fmt.Sprintf(format, singleLine(defaultText)))
 	}
 }
 
 func addLineIfNotEmpty(s *strings.Builder, data, format string) {
 	if data != "" {
-		s.WriteString(fmt.Sprintf(format, data))
+		s.WriteString(fmt.Sprintf(format, singleLine(data)))
 	}
 }
+
+// lineBreaks replaces the line breaks of the texts written in the comments, they would end the comment.
+var lineBreaks = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ")
+
+// singleLine returns the text on a single line, so it can be written in a comment of the generated file.
+func singleLine(text string) string {
+	return lineBreaks.Replace(text)
+}
+
+// AnyMethods are the methods documented for the routes registered with Any.
+// The frameworks register more methods, like CONNECT or TRACE, that are not documented.
+var AnyMethods = []string{
+	http.MethodGet,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+	http.MethodHead,
+	http.MethodOptions,
+}
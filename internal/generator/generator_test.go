@@ -2,6 +2,10 @@ package generator
 
 import (
 	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -236,7 +240,7 @@ func TestWriteGroup(t *testing.T) {
 
 			var b strings.Builder
 			var wrapperStructs strings.Builder
-			writeGroup(tt.groups, &b, map[string]bool{}, &wrapperStructs)
+			writeGroup(nil, tt.groups, &b, map[string]bool{}, &wrapperStructs)
 
 			assert.Equal(t, tt.expectedStringBuilder, b.String())
 		})
@@ -375,6 +379,42 @@ func TestWriteRoutes(t *testing.T) {
 			},
 			expectedStringBuilder: "// @Param test query string true \"test\"\n\n",
 		},
+		{
+			name:      "Should add the default and enum values of the params",
+			groupName: "",
+			routes: []Route{
+				{
+					QueryParams: []Param{
+						{
+							Name:        "sort",
+							Description: "sort order",
+							ParamType:   "string",
+							Default:     "asc",
+							Enum:        []string{"asc", "desc"},
+						},
+					},
+				},
+			},
+			expectedStringBuilder: "// @Param sort query string false \"sort order\" default(asc) Enums(asc, desc)\n\n",
+		},
+		{
+			name:      "Should add the cookie and form params and accept multipart forms with files",
+			groupName: "",
+			routes: []Route{
+				{
+					Method:       "PATCH",
+					CookieParams: []Param{{Name: "session", ParamType: "string", Required: true}},
+					FormParams: []Param{
+						{Name: "name", ParamType: "string"},
+						{Name: "photos", Description: "album photos", ParamType: "file", Array: true, CollectionFormat: "multi", MimeTypes: []string{"image/*"}, MaxSize: 1024},
+					},
+				},
+			},
+			expectedStringBuilder: "// @Accept mpfd\n" +
+				"// @Param session cookie string true \"\"\n" +
+				"// @Param name formData string false \"\"\n" +
+				"// @Param photos formData []file false \"album photos. Allowed types: image/*. Maximum size: 1024 bytes.\" collectionFormat(multi)\n\n",
+		},
 		{
 			name:      "Should add header params if we have header params",
 			groupName: "",
@@ -543,6 +583,7 @@ func Test_handleOverrideStructFields(t *testing.T) {
 func Test_writeFileContent(t *testing.T) {
 	type args struct {
 		file             io.Writer
+		packageName      string
 		content          string
 		packagesToImport map[string]bool
 	}
@@ -555,16 +596,27 @@ func Test_writeFileContent(t *testing.T) {
 			name: "Should write the file content",
 			args: args{
 				file:             &strings.Builder{},
+				packageName:      "main",
 				content:          "test",
 				packagesToImport: map[string]bool{"test": true},
 			},
 			expected: "package main\n\nimport (\n\t_ \"test\"\n)\n\ntest",
 		},
+		{
+			name: "Should write the configured package name",
+			args: args{
+				file:        &strings.Builder{},
+				packageName: "apidocs",
+				content:     "test",
+			},
+			expected: "package apidocs\n\ntest",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			writeFileContent(tt.args.file, tt.args.content, tt.args.packagesToImport)
+			writeFileContent(tt.args.file, tt.args.packageName, tt.args.content, tt.args.packagesToImport)
+			assert.Equal(t, tt.expected, tt.args.file.(*strings.Builder).String())
 		})
 	}
 }
@@ -668,6 +720,20 @@ func Test_addDefaultResponses(t *testing.T) {
 	}
 }
 
+func TestRoute_responses(t *testing.T) {
+	success := &models.ReturnType{StatusCode: http.StatusCreated}
+	notFound := models.ReturnType{StatusCode: http.StatusNotFound}
+	accepted := models.ReturnType{StatusCode: http.StatusAccepted}
+
+	assert.Equal(t, []models.ReturnType{*success, notFound}, Route{Success: success, Returns: []models.ReturnType{notFound}}.responses())
+	assert.Equal(t, []models.ReturnType{accepted, notFound}, Route{Success: success, Returns: []models.ReturnType{accepted, notFound}}.responses())
+	assert.Equal(t, []models.ReturnType{notFound}, Route{Returns: []models.ReturnType{notFound}}.responses())
+
+	// the default responses are added after the inferred success
+	routes, _ := addDefaultResponses([]Route{{Success: success}}, nil, []models.ReturnType{{StatusCode: http.StatusOK}})
+	assert.Equal(t, []Route{{Returns: []models.ReturnType{*success, {StatusCode: http.StatusOK}}}}, routes)
+}
+
 func Test_generateWrapperStruct(t *testing.T) {
 	type TestStruct struct {
 		ID    string `json:"id"`
@@ -885,10 +951,10 @@ func Test_writeRoutes_withReadFieldDescriptions(t *testing.T) {
 		{
 			name: "Should use wrapper struct when ReadFieldDescriptions are provided",
 			route: Route{
-				Path:        "/test",
-				Method:      "POST",
-				Summary:     "Test",
-				Reads:       TestRequest{},
+				Path:    "/test",
+				Method:  "POST",
+				Summary: "Test",
+				Reads:   TestRequest{},
 				ReadFieldDescriptions: map[string]string{
 					"name":  "User's full name",
 					"email": "User's email address",
@@ -1040,7 +1106,7 @@ func Test_ensurePointerTags(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			result := ensurePointerTags(tt.tag)
 			resultStr := string(result)
-			
+
 			// Check that expected strings are in the result
 			if strings.Contains(tt.expected, "json:") {
 				assert.Contains(t, resultStr, tt.expected, "Expected JSON tag: %s", tt.expected)
@@ -1127,3 +1193,232 @@ func Test_addPackageToImport(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerate_output(t *testing.T) {
+	routes := []Route{
+		{
+			Path:     "/test",
+			Method:   "GET",
+			FuncName: "handleTest",
+			Returns:  []models.ReturnType{{StatusCode: 200, Body: testutil.TestGeneric{}}},
+		},
+	}
+
+	t.Run("Should write to the writer with the configured package", func(t *testing.T) {
+		var b strings.Builder
+		err := Generate(routes, nil, nil, models.GenerateOptions{Writer: &b, PackageName: "apidocs"})
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(b.String(), "package apidocs\n"))
+		assert.Contains(t, b.String(), "func handleTest() {}")
+	})
+
+	t.Run("Should number the functions of a handler registered several times", func(t *testing.T) {
+		var b strings.Builder
+		repeated := []Route{
+			{Path: "/items", Method: "GET", FuncName: "handleItems"},
+			{Path: "/items", Method: "POST", FuncName: "handleItems"},
+		}
+		groups := []Group{{GroupName: "/v2", Routes: []Route{{Path: "/v2/items", Method: "GET", FuncName: "handleItems"}}}}
+
+		err := Generate(repeated, groups, nil, models.GenerateOptions{Writer: &b})
+		assert.NoError(t, err)
+		assert.Contains(t, b.String(), "func handleItems() {}")
+		assert.Contains(t, b.String(), "func handleItems2() {}")
+		assert.Contains(t, b.String(), "func handleItems3() {}")
+	})
+
+	t.Run("Should name the handlers that are not identifiers after the method and the path", func(t *testing.T) {
+		var b strings.Builder
+		closures := []Route{
+			{Path: "/users/{id}", Method: "GET", FuncName: "1"},
+			{Path: "/users/{id}", Method: "DELETE", FuncName: "handle[...]"},
+			{Path: "/1", FuncName: "2"},
+		}
+
+		err := Generate(closures, nil, nil, models.GenerateOptions{Writer: &b})
+		assert.NoError(t, err)
+		assert.Contains(t, b.String(), "func getUsersId() {}")
+		assert.Contains(t, b.String(), "func deleteUsersId() {}")
+		assert.Contains(t, b.String(), "func handler1() {}")
+
+		doc := BuildOpenAPI(closures, nil, nil, nil)
+		assert.Equal(t, "getUsersId", doc.Paths["/users/{id}"]["get"].OperationID)
+	})
+
+	t.Run("Should write the texts with line breaks on a single line", func(t *testing.T) {
+		var b strings.Builder
+		multiline := []Route{
+			{
+				Path:        "/test",
+				Method:      "GET",
+				FuncName:    "handleTest",
+				Summary:     "Get\ntest",
+				Description: "First line.\r\nSecond line.",
+				QueryParams: []Param{{Name: "q", Description: "search\nterms", ParamType: "string"}},
+			},
+		}
+
+		err := Generate(multiline, nil, nil, models.GenerateOptions{Writer: &b, Info: &models.Info{Title: "Test\nAPI"}})
+		assert.NoError(t, err)
+		assert.Contains(t, b.String(), "// @title Test API\n")
+		assert.Contains(t, b.String(), "// @Summary Get test\n")
+		assert.Contains(t, b.String(), "// @Description First line. Second line.\n")
+		assert.Contains(t, b.String(), `// @Param q query string false "search terms"`)
+	})
+
+	t.Run("Should write the general info above the package", func(t *testing.T) {
+		var b strings.Builder
+		err := Generate(routes, nil, nil, models.GenerateOptions{Writer: &b, Info: &models.Info{Title: "Test API", Version: "1.2"}})
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(b.String(), "// @title Test API\n// @version 1.2\n\npackage main\n"))
+	})
+
+	t.Run("Should create the output directory and file", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "internal", "apidocs")
+		err := Generate(routes, nil, nil, models.GenerateOptions{OutputDir: dir, FileName: "docs.go", PackageName: "apidocs"})
+		assert.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(dir, "docs.go"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "// @Router /test [get]")
+	})
+
+	t.Run("Should write the openapi document to the output directory", func(t *testing.T) {
+		dir := t.TempDir()
+		err := Generate(routes, nil, nil, models.GenerateOptions{Format: models.FormatOpenAPI, OutputDir: dir})
+		assert.NoError(t, err)
+		assert.FileExists(t, filepath.Join(dir, "openapi.json"))
+	})
+
+	t.Run("Should write the swagger 2.0 documents to the output directory", func(t *testing.T) {
+		dir := t.TempDir()
+		assert.NoError(t, Generate(routes, nil, nil, models.GenerateOptions{Format: models.FormatSwagger2JSON, OutputDir: dir}))
+		assert.NoError(t, Generate(routes, nil, nil, models.GenerateOptions{Format: models.FormatSwagger2YAML, OutputDir: dir}))
+
+		content, err := os.ReadFile(filepath.Join(dir, "swagger.yaml"))
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(content), "swagger: \"2.0\"\n"))
+		assert.FileExists(t, filepath.Join(dir, "swagger.json"))
+	})
+
+	t.Run("Should return an output error if the file can not be written", func(t *testing.T) {
+		dir := t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "file"), nil, 0o644))
+
+		err := Generate(routes, nil, nil, models.GenerateOptions{OutputDir: filepath.Join(dir, "file")})
+
+		var outputErr *models.OutputError
+		assert.ErrorAs(t, err, &outputErr)
+	})
+
+	t.Run("Should reject invalid package names", func(t *testing.T) {
+		err := Generate(routes, nil, nil, models.GenerateOptions{Writer: io.Discard, PackageName: "api-docs"})
+		assert.EqualError(t, err, `goswag: invalid option PackageName: "api-docs" is not an identifier`)
+
+		var optionsErr *models.OptionsError
+		assert.ErrorAs(t, err, &optionsErr)
+	})
+}
+
+func TestGenerateSwagger_lenient(t *testing.T) {
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	assert.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	var logs strings.Builder
+	log.SetOutput(&logs)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	routes := []Route{
+		{
+			Path:     "/items",
+			Method:   "GET",
+			FuncName: "listItems",
+			QueryParams: []Param{
+				{Name: "price", ParamType: "float"},
+				{Name: "filter", ParamType: "object"},
+				{Name: "tags", ParamType: "[]string"},
+			},
+		},
+		{Method: "GET", FuncName: "handleRoot"},
+	}
+
+	GenerateSwagger(routes, nil, nil, nil)
+
+	content, err := os.ReadFile(filepath.Join(dir, fileName))
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "// @Param price query float false")
+	assert.Contains(t, string(content), "// @Param filter query object false")
+	assert.Contains(t, string(content), "// @Param tags query []string false")
+	assert.Contains(t, string(content), "func handleRoot() {}")
+
+	assert.Contains(t, logs.String(), `warning: goswag: invalid annotation on GET /items: unknown type "float" for query parameter "price"`)
+	assert.Contains(t, logs.String(), "warning: goswag: invalid annotation on GET: empty path")
+}
+
+func TestGenerate_deterministic(t *testing.T) {
+	type Request struct {
+		Name string `json:"name"`
+	}
+
+	routes := []Route{
+		{
+			Path:                  "/a",
+			Method:                "POST",
+			FuncName:              "handleA",
+			Reads:                 Request{},
+			ReadFieldDescriptions: map[string]string{"name": "Name of A"},
+			Returns: []models.ReturnType{
+				{StatusCode: 200, Body: models.ReturnType{}},
+				{
+					StatusCode: 201,
+					Body:       testutil.OverrideStruct{},
+					OverrideStructFields: map[string]interface{}{
+						"c": testutil.TestGeneric{},
+						"a": testutil.TestGeneric{},
+						"b": testutil.TestGeneric{},
+					},
+				},
+			},
+		},
+		{
+			Path:                  "/b",
+			Method:                "POST",
+			FuncName:              "handleB",
+			Reads:                 Request{},
+			ReadFieldDescriptions: map[string]string{"name": "Name of A"},
+		},
+		{
+			Path:                  "/c",
+			Method:                "POST",
+			FuncName:              "handleC",
+			Reads:                 Request{},
+			ReadFieldDescriptions: map[string]string{"name": "Name of C"},
+		},
+	}
+
+	var first strings.Builder
+	assert.NoError(t, Generate(routes, nil, nil, models.GenerateOptions{Writer: &first}))
+
+	for i := 0; i < 10; i++ {
+		var b strings.Builder
+		assert.NoError(t, Generate(routes, nil, nil, models.GenerateOptions{Writer: &b}))
+		assert.Equal(t, first.String(), b.String())
+	}
+
+	content := first.String()
+	assert.Contains(t, content, "import (\n"+
+		"\t_ \"github.com/r0bertson/goswag/internal/generator\"\n"+
+		"\t_ \"github.com/r0bertson/goswag/internal/generator/testutil\"\n"+
+		"\t_ \"github.com/r0bertson/goswag/models\"\n)")
+	assert.Contains(t, content, "{a=testutil.TestGeneric,b=testutil.TestGeneric,c=testutil.TestGeneric}")
+	// the same struct with the same descriptions is declared once
+	assert.Equal(t, 1, strings.Count(content, "type Wrappergenerator_RequestRequest struct"))
+	assert.Equal(t, 1, strings.Count(content, "type Wrappergenerator_RequestRequest2 struct"))
+	assert.Contains(t, content, "// @Param request body Wrappergenerator_RequestRequest2 true \"Request\"\n// @Router /c [post]")
+	// the output is gofmt clean
+	assert.Contains(t, content, "func handleA() {} //nolint:unused\n")
+}
@@ -0,0 +1,254 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/r0bertson/goswag/models"
+)
+
+const bearerDescription = `Type "Bearer" followed by a space and the token.`
+
+// documentInfo returns the info object of the documents, the title and version are required.
+func documentInfo(info *models.Info) DocumentInfo {
+	d := DocumentInfo{Title: "API", Version: "1.0"}
+	if info == nil {
+		return d
+	}
+
+	if info.Title != "" {
+		d.Title = info.Title
+	}
+
+	if info.Version != "" {
+		d.Version = info.Version
+	}
+
+	d.Description = info.Description
+	d.TermsOfService = info.TermsOfService
+
+	if info.Contact != nil {
+		d.Contact = &DocumentContact{Name: info.Contact.Name, URL: info.Contact.URL, Email: info.Contact.Email}
+	}
+
+	if info.License != nil {
+		d.License = &DocumentLicense{Name: info.License.Name, URL: info.License.URL}
+	}
+
+	return d
+}
+
+// servers returns the OpenAPI servers, derived from the host, base path and schemes when not set.
+func servers(info *models.Info) []Server {
+	if info == nil {
+		return nil
+	}
+
+	var s []Server
+	for _, server := range info.Servers {
+		s = append(s, Server{URL: server.URL, Description: server.Description})
+	}
+
+	if len(s) > 0 || (info.Host == "" && info.BasePath == "") {
+		return s
+	}
+
+	if info.Host == "" {
+		return []Server{{URL: info.BasePath}}
+	}
+
+	schemes := info.Schemes
+	if len(schemes) == 0 {
+		schemes = []string{"https"}
+	}
+
+	for _, scheme := range schemes {
+		s = append(s, Server{URL: scheme + "://" + info.Host + info.BasePath})
+	}
+
+	return s
+}
+
+// hostAndBasePath returns the Swagger 2.0 host, base path and schemes, derived from the first server when not set.
+func hostAndBasePath(info *models.Info) (string, string, []string) {
+	if info == nil {
+		return "", "", nil
+	}
+
+	if info.Host != "" || info.BasePath != "" || len(info.Servers) == 0 {
+		return info.Host, info.BasePath, info.Schemes
+	}
+
+	u, err := url.Parse(info.Servers[0].URL)
+	if err != nil {
+		return "", "", nil
+	}
+
+	var schemes []string
+	if u.Scheme != "" {
+		schemes = []string{u.Scheme}
+	}
+
+	return u.Host, u.Path, schemes
+}
+
+// securitySchemes returns the schemes configured in the info. The schemes referenced by the routes
+// but not configured are reported by the validation, they are not made up from their names.
+func securitySchemes(info *models.Info) map[string]models.SecurityScheme {
+	if info == nil || len(info.SecuritySchemes) == 0 {
+		return nil
+	}
+
+	return info.SecuritySchemes
+}
+
+// openAPISecurityScheme translates a scheme to OpenAPI 3.1.
+func openAPISecurityScheme(scheme models.SecurityScheme) *SecurityScheme {
+	s := &SecurityScheme{Description: scheme.Description}
+
+	switch scheme.Type {
+	case models.SecurityBearer:
+		s.Type, s.Scheme, s.BearerFormat = "http", "bearer", scheme.BearerFormat
+	case models.SecurityBasic:
+		s.Type, s.Scheme = "http", "basic"
+	case models.SecurityOAuth2:
+		scopes := scheme.Scopes
+		if scopes == nil {
+			scopes = map[string]string{}
+		}
+
+		flow := &OAuthFlow{Scopes: scopes}
+		switch scheme.Flow {
+		case models.OAuth2Implicit:
+			flow.AuthorizationURL = scheme.AuthorizationURL
+		case models.OAuth2AuthorizationCode:
+			flow.AuthorizationURL, flow.TokenURL = scheme.AuthorizationURL, scheme.TokenURL
+		default:
+			flow.TokenURL = scheme.TokenURL
+		}
+
+		s.Type = "oauth2"
+		s.Flows = map[string]*OAuthFlow{string(oauth2Flow(scheme.Flow)): flow}
+	default:
+		s.Type, s.Name, s.In = "apiKey", scheme.Name, scheme.In
+	}
+
+	return s
+}
+
+// swagger2SecurityScheme translates a scheme to Swagger 2.0, where bearer tokens are api keys.
+func swagger2SecurityScheme(scheme models.SecurityScheme) *Swagger2SecurityScheme {
+	s := &Swagger2SecurityScheme{Description: scheme.Description}
+
+	switch scheme.Type {
+	case models.SecurityBasic:
+		s.Type = "basic"
+	case models.SecurityOAuth2:
+		s.Type = "oauth2"
+		s.Flow = swagger2Flow(scheme.Flow)
+		s.Scopes = scheme.Scopes
+		if s.Scopes == nil {
+			s.Scopes = map[string]string{}
+		}
+
+		if s.Flow == "implicit" || s.Flow == "accessCode" {
+			s.AuthorizationURL = scheme.AuthorizationURL
+		}
+
+		if s.Flow != "implicit" {
+			s.TokenURL = scheme.TokenURL
+		}
+	case models.SecurityBearer:
+		s.Type, s.Name, s.In = "apiKey", "Authorization", "header"
+		if s.Description == "" {
+			s.Description = bearerDescription
+		}
+	default:
+		s.Type, s.Name, s.In = "apiKey", scheme.Name, scheme.In
+	}
+
+	return s
+}
+
+func oauth2Flow(flow models.OAuth2Flow) models.OAuth2Flow {
+	if flow == "" {
+		return models.OAuth2ClientCredentials
+	}
+
+	return flow
+}
+
+// swagger2Flow returns the Swagger 2.0 name of an OAuth2 flow.
+func swagger2Flow(flow models.OAuth2Flow) string {
+	switch oauth2Flow(flow) {
+	case models.OAuth2ClientCredentials:
+		return "application"
+	case models.OAuth2AuthorizationCode:
+		return "accessCode"
+	default:
+		return string(flow)
+	}
+}
+
+// writeGeneralInfo writes the general API info comments read by swag, they must be in the file passed to swag with -g.
+func writeGeneralInfo(w io.Writer, info *models.Info) {
+	if info == nil {
+		return
+	}
+
+	d := documentInfo(info)
+	line := func(attribute, value string) {
+		if value != "" {
+			fmt.Fprintf(w, "// @%s %s\n", attribute, singleLine(value))
+		}
+	}
+
+	line("title", d.Title)
+	line("version", d.Version)
+	line("description", d.Description)
+	line("termsOfService", d.TermsOfService)
+
+	if d.Contact != nil {
+		line("contact.name", d.Contact.Name)
+		line("contact.url", d.Contact.URL)
+		line("contact.email", d.Contact.Email)
+	}
+
+	if d.License != nil {
+		line("license.name", d.License.Name)
+		line("license.url", d.License.URL)
+	}
+
+	host, basePath, schemes := hostAndBasePath(info)
+	line("host", host)
+	line("BasePath", basePath)
+	line("schemes", strings.Join(schemes, " "))
+
+	schemesByName := securitySchemes(info)
+	for _, name := range sortedKeys(schemesByName) {
+		s := swagger2SecurityScheme(schemesByName[name])
+
+		switch s.Type {
+		case "basic":
+			line("securityDefinitions.basic", name)
+		case "oauth2":
+			line("securityDefinitions.oauth2."+s.Flow, name)
+			line("authorizationUrl", s.AuthorizationURL)
+			line("tokenUrl", s.TokenURL)
+
+			for _, scope := range sortedKeys(s.Scopes) {
+				line("scope."+scope, s.Scopes[scope])
+			}
+		default:
+			line("securityDefinitions.apikey", name)
+			line("in", s.In)
+			line("name", s.Name)
+		}
+
+		line("description", s.Description)
+	}
+
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,125 @@
+package generator
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+var testInfo = &models.Info{
+	Title:          "Users API",
+	Version:        "2.1",
+	Description:    "Manages the users",
+	TermsOfService: "https://example.com/terms",
+	Contact:        &models.Contact{Name: "Team", Email: "team@example.com"},
+	License:        &models.License{Name: "MIT", URL: "https://opensource.org/licenses/MIT"},
+	Host:           "api.example.com",
+	BasePath:       "/v1",
+	Schemes:        []string{"https"},
+	SecuritySchemes: map[string]models.SecurityScheme{
+		"JWT":    {Type: models.SecurityBearer, BearerFormat: "JWT"},
+		"ApiKey": {Type: models.SecurityAPIKey, Name: "X-API-Key", In: "header"},
+		"OAuth2": {
+			Type:     models.SecurityOAuth2,
+			Flow:     models.OAuth2AuthorizationCode,
+			TokenURL: "https://example.com/token", AuthorizationURL: "https://example.com/authorize",
+			Scopes: map[string]string{"read": "Read access"},
+		},
+	},
+}
+
+var infoRoutes = []Route{
+	{Path: "/users", Method: http.MethodGet, FuncName: "listUsers", Security: []string{"JWT", "ApiKey"}},
+}
+
+func TestBuildOpenAPI_info(t *testing.T) {
+	doc := BuildOpenAPI(infoRoutes, nil, nil, testInfo)
+
+	assert.Equal(t, DocumentInfo{
+		Title:          "Users API",
+		Description:    "Manages the users",
+		TermsOfService: "https://example.com/terms",
+		Contact:        &DocumentContact{Name: "Team", Email: "team@example.com"},
+		License:        &DocumentLicense{Name: "MIT", URL: "https://opensource.org/licenses/MIT"},
+		Version:        "2.1",
+	}, doc.Info)
+	assert.Equal(t, []Server{{URL: "https://api.example.com/v1"}}, doc.Servers)
+	assert.Equal(t, map[string]*SecurityScheme{
+		"JWT":    {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+		"ApiKey": {Type: "apiKey", Name: "X-API-Key", In: "header"},
+		"OAuth2": {Type: "oauth2", Flows: map[string]*OAuthFlow{
+			"authorizationCode": {AuthorizationURL: "https://example.com/authorize", TokenURL: "https://example.com/token", Scopes: map[string]string{"read": "Read access"}},
+		}},
+	}, doc.Components.SecuritySchemes)
+}
+
+func TestBuildOpenAPI_undefinedSecuritySchemes(t *testing.T) {
+	// the schemes referenced by the routes are not made up from their names
+	assert.Nil(t, BuildOpenAPI(infoRoutes, nil, nil, nil).Components)
+	assert.Nil(t, BuildSwagger2(infoRoutes, nil, nil, nil).SecurityDefinitions)
+}
+
+func TestBuildSwagger2_info(t *testing.T) {
+	doc := BuildSwagger2(infoRoutes, nil, nil, testInfo)
+
+	assert.Equal(t, "Users API", doc.Info.Title)
+	assert.Equal(t, "api.example.com", doc.Host)
+	assert.Equal(t, "/v1", doc.BasePath)
+	assert.Equal(t, []string{"https"}, doc.Schemes)
+	assert.Equal(t, map[string]*Swagger2SecurityScheme{
+		"JWT":    {Type: "apiKey", Name: "Authorization", In: "header", Description: bearerDescription},
+		"ApiKey": {Type: "apiKey", Name: "X-API-Key", In: "header"},
+		"OAuth2": {
+			Type: "oauth2", Flow: "accessCode",
+			AuthorizationURL: "https://example.com/authorize", TokenURL: "https://example.com/token",
+			Scopes: map[string]string{"read": "Read access"},
+		},
+	}, doc.SecurityDefinitions)
+}
+
+func TestHostAndBasePath(t *testing.T) {
+	host, basePath, schemes := hostAndBasePath(&models.Info{Servers: []models.Server{{URL: "http://localhost:8080/api"}}})
+	assert.Equal(t, "localhost:8080", host)
+	assert.Equal(t, "/api", basePath)
+	assert.Equal(t, []string{"http"}, schemes)
+
+	assert.Equal(t, []Server{{URL: "/api"}}, servers(&models.Info{BasePath: "/api"}))
+	assert.Nil(t, servers(&models.Info{Title: "API"}))
+}
+
+func TestWriteGeneralInfo(t *testing.T) {
+	var b strings.Builder
+	writeGeneralInfo(&b, testInfo)
+
+	assert.Equal(t, `// @title Users API
+// @version 2.1
+// @description Manages the users
+// @termsOfService https://example.com/terms
+// @contact.name Team
+// @contact.email team@example.com
+// @license.name MIT
+// @license.url https://opensource.org/licenses/MIT
+// @host api.example.com
+// @BasePath /v1
+// @schemes https
+// @securityDefinitions.apikey ApiKey
+// @in header
+// @name X-API-Key
+// @securityDefinitions.apikey JWT
+// @in header
+// @name Authorization
+// @description Type "Bearer" followed by a space and the token.
+// @securityDefinitions.oauth2.accessCode OAuth2
+// @authorizationUrl https://example.com/authorize
+// @tokenUrl https://example.com/token
+// @scope.read Read access
+
+`, b.String())
+
+	b.Reset()
+	writeGeneralInfo(&b, nil)
+	assert.Empty(t, b.String())
+}
@@ -0,0 +1,541 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/r0bertson/goswag/models"
+)
+
+const (
+	openAPIFileName  = "openapi.json"
+	openAPIVersion   = "3.1.0"
+	openAPIRefPrefix = "#/components/schemas/"
+)
+
+// Document is the root of an OpenAPI 3.1 document.
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       DocumentInfo        `json:"info"`
+	Servers    []Server            `json:"servers,omitempty"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components *Components         `json:"components,omitempty"`
+}
+
+// DocumentInfo is the info object, shared by the OpenAPI 3.1 and Swagger 2.0 documents.
+type DocumentInfo struct {
+	Title          string           `json:"title"`
+	Description    string           `json:"description,omitempty"`
+	TermsOfService string           `json:"termsOfService,omitempty"`
+	Contact        *DocumentContact `json:"contact,omitempty"`
+	License        *DocumentLicense `json:"license,omitempty"`
+	Version        string           `json:"version"`
+}
+
+type DocumentContact struct {
+	Name  string `json:"name,omitempty"`
+	URL   string `json:"url,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+type DocumentLicense struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type Server struct {
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem holds the operations of a path keyed by the lower case http method.
+type PathItem map[string]*Operation
+
+type Operation struct {
+	Tags        []string              `json:"tags,omitempty"`
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	OperationID string                `json:"operationId,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Deprecated  bool    `json:"deprecated,omitempty"`
+	Style       string  `json:"style,omitempty"`
+	Explode     *bool   `json:"explode,omitempty"`
+	Schema      *Schema `json:"schema,omitempty"`
+	Example     any     `json:"example,omitempty"`
+}
+
+type RequestBody struct {
+	Description string               `json:"description,omitempty"`
+	Required    bool                 `json:"required,omitempty"`
+	Content     map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema   *Schema             `json:"schema,omitempty"`
+	Encoding map[string]Encoding `json:"encoding,omitempty"`
+}
+
+// Encoding describes how a property of a multipart/form-data body is sent.
+type Encoding struct {
+	ContentType string `json:"contentType,omitempty"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas,omitempty"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type SecurityScheme struct {
+	Type         string                `json:"type"`
+	Description  string                `json:"description,omitempty"`
+	Name         string                `json:"name,omitempty"`
+	In           string                `json:"in,omitempty"`
+	Scheme       string                `json:"scheme,omitempty"`
+	BearerFormat string                `json:"bearerFormat,omitempty"`
+	Flows        map[string]*OAuthFlow `json:"flows,omitempty"`
+}
+
+type OAuthFlow struct {
+	AuthorizationURL string            `json:"authorizationUrl,omitempty"`
+	TokenURL         string            `json:"tokenUrl,omitempty"`
+	Scopes           map[string]string `json:"scopes"`
+}
+
+// Resolve returns the component schema referenced by s, or s when it is not a reference.
+func (d *Document) Resolve(s *Schema) *Schema {
+	if s == nil || s.Ref == "" {
+		return s
+	}
+
+	if d.Components == nil {
+		return nil
+	}
+
+	return d.Components.Schemas[strings.TrimPrefix(s.Ref, openAPIRefPrefix)]
+}
+
+// GenerateOpenAPI writes an OpenAPI 3.1 document built from the routes and groups,
+// without relying on the swag binary, and stops the program if it can not be written.
+// The invalid annotations are logged as warnings, Generate rejects them.
+func GenerateOpenAPI(routes []Route, groups []Group, defaultResponses []models.ReturnType, info *models.Info) {
+	generateLenient(routes, groups, defaultResponses, models.GenerateOptions{Format: models.FormatOpenAPI, Logger: log.Default(), Info: info})
+}
+
+func generateOpenAPI(routes []Route, groups []Group, defaultResponses []models.ReturnType, opts models.GenerateOptions) error {
+	filePath := outputPath(opts, openAPIFileName)
+	logf(opts, "Generating %s file...", filePath)
+
+	content, err := MarshalOpenAPI(routes, groups, defaultResponses, opts.Info)
+	if err != nil {
+		return &models.OutputError{Path: filePath, Err: err}
+	}
+
+	if err := writeOutput(filePath, content, opts); err != nil {
+		return err
+	}
+
+	logf(opts, "%s file generated successfully!", filePath)
+
+	return nil
+}
+
+// MarshalOpenAPI returns the indented json of the OpenAPI 3.1 document built from the routes and groups.
+func MarshalOpenAPI(routes []Route, groups []Group, defaultResponses []models.ReturnType, info *models.Info) ([]byte, error) {
+	return json.MarshalIndent(BuildOpenAPI(routes, groups, defaultResponses, info), "", "  ")
+}
+
+// BuildOpenAPI builds an OpenAPI 3.1 document from the routes and groups.
+// The schemas of the request and response bodies are built by reflection over their types.
+// The general info is optional.
+func BuildOpenAPI(routes []Route, groups []Group, defaultResponses []models.ReturnType, info *models.Info) *Document {
+	b := &openAPIBuilder{
+		doc: &Document{
+			OpenAPI: openAPIVersion,
+			Info:    documentInfo(info),
+			Servers: servers(info),
+			Paths:   make(map[string]PathItem),
+		},
+		schemas:          NewSchemaBuilder(openAPIRefPrefix),
+		defaultResponses: defaultResponses,
+		operationIDs:     make(operationIDs),
+	}
+
+	b.addRoutes(nil, routes)
+	b.addGroups(nil, groups)
+
+	components := &Components{}
+	if len(b.schemas.Definitions()) > 0 {
+		components.Schemas = b.schemas.Definitions()
+	}
+
+	for name, scheme := range securitySchemes(info) {
+		if components.SecuritySchemes == nil {
+			components.SecuritySchemes = make(map[string]*SecurityScheme)
+		}
+
+		components.SecuritySchemes[name] = openAPISecurityScheme(scheme)
+	}
+
+	if components.Schemas != nil || components.SecuritySchemes != nil {
+		b.doc.Components = components
+	}
+
+	return b.doc
+}
+
+type openAPIBuilder struct {
+	doc              *Document
+	schemas          *SchemaBuilder
+	defaultResponses []models.ReturnType
+	operationIDs     operationIDs
+}
+
+func (b *openAPIBuilder) addGroups(parentTags []string, groups []Group) {
+	for _, g := range groups {
+		tags := groupTags(parentTags, g)
+		b.addRoutes(tags, g.Routes)
+		b.addGroups(tags, g.Groups)
+	}
+}
+
+func (b *openAPIBuilder) addRoutes(groupTags []string, routes []Route) {
+	for _, r := range routes {
+		if r.Path == "" || r.Method == "" {
+			continue
+		}
+
+		path := OpenAPIPath(r.Path)
+
+		item, ok := b.doc.Paths[path]
+		if !ok {
+			item = make(PathItem)
+			b.doc.Paths[path] = item
+		}
+
+		item[strings.ToLower(r.Method)] = b.operation(groupTags, r)
+	}
+}
+
+func (b *openAPIBuilder) operation(groupTags []string, r Route) *Operation {
+	op := &Operation{
+		Summary:     r.Summary,
+		Description: r.Description,
+		OperationID: b.operationIDs.next(routeFuncName(r)),
+		Responses:   make(map[string]*Response),
+	}
+
+	if op.Description == "" {
+		op.Description = r.Summary
+	}
+
+	if len(r.Tags) > 0 {
+		op.Tags = r.Tags
+	} else if len(groupTags) > 0 {
+		op.Tags = groupTags
+	}
+
+	op.Parameters = append(op.Parameters, toParameters("path", r.PathParams)...)
+	op.Parameters = append(op.Parameters, toParameters("query", r.QueryParams)...)
+	op.Parameters = append(op.Parameters, toParameters("header", r.HeaderParams)...)
+	op.Parameters = append(op.Parameters, toParameters("cookie", r.CookieParams)...)
+
+	if len(r.FormParams) > 0 {
+		op.RequestBody = formBody(r)
+	} else if body := r.body(); body != nil {
+		schema := bodySchema(b.schemas, b.schemas.RequestSchema(body), r.ReadFieldDescriptions, nil)
+		op.RequestBody = &RequestBody{
+			Description: "Request",
+			Required:    true,
+			Content:     content(r.Accepts, schema),
+		}
+	}
+
+	returns := append(append([]models.ReturnType{}, r.responses()...), b.defaultResponses...)
+	for _, data := range returns {
+		if data.StatusCode == 0 {
+			continue
+		}
+
+		resp := &Response{Description: http.StatusText(data.StatusCode)}
+		if data.Body != nil {
+			schema := bodySchema(b.schemas, b.schemas.Schema(data.Body), data.FieldDescriptions, data.OverrideStructFields)
+			resp.Content = content(r.Produces, schema)
+		}
+
+		op.Responses[strconv.Itoa(data.StatusCode)] = resp
+	}
+
+	if len(op.Responses) == 0 {
+		op.Responses["default"] = &Response{Description: "Default response"}
+	}
+
+	for _, scheme := range r.Security {
+		if strings.TrimSpace(scheme) == "" {
+			continue
+		}
+		op.Security = append(op.Security, map[string][]string{scheme: {}})
+	}
+
+	return op
+}
+
+// bodySchema returns the schema of a body built by schemas. When field descriptions or overridden fields
+// are given, the struct schema is copied inline so the definition stays untouched.
+func bodySchema(schemas *SchemaBuilder, schema *Schema, descriptions map[string]string, overrides map[string]interface{}) *Schema {
+	if len(descriptions) == 0 && len(overrides) == 0 {
+		return schema
+	}
+
+	resolved := schemas.Resolve(schema)
+	if resolved == nil || resolved.Properties == nil {
+		return schema
+	}
+
+	inline := *resolved
+	inline.Properties = make(map[string]*Schema, len(resolved.Properties))
+	for name, prop := range resolved.Properties {
+		inline.Properties[name] = prop
+	}
+
+	for name, object := range overrides {
+		inline.Properties[name] = schemas.Schema(object)
+	}
+
+	for name, description := range descriptions {
+		prop, ok := inline.Properties[name]
+		if !ok {
+			continue
+		}
+
+		described := *prop
+		described.Description = description
+		inline.Properties[name] = &described
+	}
+
+	return &inline
+}
+
+// operationIDs counts the handler function names used as operation ids.
+type operationIDs map[string]int
+
+// next returns a unique operation id based on the handler function name.
+func (ids operationIDs) next(funcName string) string {
+	if funcName == "" {
+		return ""
+	}
+
+	ids[funcName]++
+	if count := ids[funcName]; count > 1 {
+		return fmt.Sprintf("%s%d", funcName, count)
+	}
+
+	return funcName
+}
+
+func toParameters(in string, params []Param) []Parameter {
+	var parameters []Parameter
+	for _, p := range params {
+		style, explode := parameterStyle(in, p)
+
+		parameters = append(parameters, Parameter{
+			Name:        p.Name,
+			In:          in,
+			Description: paramDescription(p),
+			// path parameters are always required in OpenAPI
+			Required:   p.Required || in == "path",
+			Deprecated: p.Deprecated,
+			Style:      style,
+			Explode:    explode,
+			Schema:     paramSchema(p),
+			Example:    paramExample(p),
+		})
+	}
+
+	return parameters
+}
+
+// formBody returns the request body of the form parameters, an object with one property per parameter.
+// The mime types of the files are the content types of their multipart encoding.
+func formBody(r Route) *RequestBody {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	encoding := make(map[string]Encoding)
+	for _, p := range r.FormParams {
+		property := paramSchema(p)
+		property.Description = paramDescription(p)
+		schema.Properties[p.Name] = property
+
+		if p.Required {
+			schema.Required = append(schema.Required, p.Name)
+		}
+
+		if len(p.MimeTypes) > 0 {
+			encoding[p.Name] = Encoding{ContentType: strings.Join(p.MimeTypes, ", ")}
+		}
+	}
+
+	body := &RequestBody{Required: len(schema.Required) > 0, Content: make(map[string]MediaType)}
+	for _, m := range mimeTypes(routeAccepts(r)) {
+		media := MediaType{Schema: schema}
+		if m == "multipart/form-data" && len(encoding) > 0 {
+			media.Encoding = encoding
+		}
+
+		body.Content[m] = media
+	}
+
+	return body
+}
+
+// parameterStyle returns how the values of an array parameter are serialized according to its
+// collection format. The csv values of path and header parameters use their default style,
+// tab separated values can not be described.
+func parameterStyle(in string, p Param) (string, *bool) {
+	if !p.Array || in != "query" {
+		return "", nil
+	}
+
+	explode := false
+	switch p.CollectionFormat {
+	case "multi":
+		explode = true
+		return "form", &explode
+	case "ssv":
+		return "spaceDelimited", &explode
+	case "pipes":
+		return "pipeDelimited", &explode
+	case "", "csv":
+		return "form", &explode
+	default:
+		return "", nil
+	}
+}
+
+// typeSchema maps the parameter types accepted by goswag to JSON Schema types.
+func typeSchema(paramType string) *Schema {
+	switch paramType {
+	case "int", "integer":
+		return &Schema{Type: "integer"}
+	case "number":
+		return &Schema{Type: "number"}
+	case "bool", "boolean":
+		return &Schema{Type: "boolean"}
+	case "file":
+		return &Schema{Type: "string", Format: "binary"}
+	default:
+		return &Schema{Type: "string"}
+	}
+}
+
+func content(mimeTypes []string, schema *Schema) map[string]MediaType {
+	if len(mimeTypes) == 0 || strings.TrimSpace(mimeTypes[0]) == "" {
+		mimeTypes = []string{"json"}
+	}
+
+	c := make(map[string]MediaType, len(mimeTypes))
+	for _, m := range mimeTypes {
+		c[mimeType(m)] = MediaType{Schema: schema}
+	}
+
+	return c
+}
+
+// mimeType translates the swag mime type aliases to the full mime type.
+// swag docs: https://github.com/swaggo/swag#mime-types
+func mimeType(alias string) string {
+	switch alias {
+	case "json":
+		return "application/json"
+	case "xml":
+		return "application/xml"
+	case "plain":
+		return "text/plain"
+	case "html":
+		return "text/html"
+	case "mpfd":
+		return "multipart/form-data"
+	case "x-www-form-urlencoded":
+		return "application/x-www-form-urlencoded"
+	case "json-api":
+		return "application/vnd.api+json"
+	case "json-stream":
+		return "application/x-json-stream"
+	case "octet-stream":
+		return "application/octet-stream"
+	case "png", "jpeg", "gif":
+		return "image/" + alias
+	default:
+		return alias
+	}
+}
+
+var (
+	colonParamRegex    = regexp.MustCompile(`(^|/):([^/]+)`)
+	catchAllParamRegex = regexp.MustCompile(`(^|/)\*([^/]+)`)
+	templateParamRegex = regexp.MustCompile(`{([^/{}]+)}`)
+)
+
+// OpenAPIPath converts the path syntax of the frameworks to the OpenAPI path template.
+// example: /users/:id becomes /users/{id}, /files/*path and /files/{path...} become /files/{path}
+// and the end of path marker of net/http, /{$}, becomes /.
+func OpenAPIPath(path string) string {
+	path = colonParamRegex.ReplaceAllString(path, "$1{$2}")
+	path = catchAllParamRegex.ReplaceAllString(path, "$1{$2}")
+	path = strings.ReplaceAll(path, "{$}", "")
+
+	return strings.ReplaceAll(path, "...}", "}")
+}
+
+// PathTemplate converts the path with OpenAPIPath and returns its variables as required string
+// path parameters, so the routes document them even without a PathParam call.
+func PathTemplate(path string) (string, []Param) {
+	path = OpenAPIPath(path)
+
+	var params []Param
+	for _, match := range templateParamRegex.FindAllStringSubmatch(path, -1) {
+		params = SetParam(params, Param{Name: match[1], ParamType: "string", Required: true})
+	}
+
+	return path, params
+}
+
+// SetParam replaces the params with the same name or appends them, the pattern of a replaced param
+// is kept when the new one has none. It refines the path parameters declared from the path of the routes.
+func SetParam(params []Param, ps ...Param) []Param {
+next:
+	for _, p := range ps {
+		for i := range params {
+			if params[i].Name == p.Name {
+				if p.Pattern == "" {
+					p.Pattern = params[i].Pattern
+				}
+
+				params[i] = p
+				continue next
+			}
+		}
+
+		params = append(params, p)
+	}
+
+	return params
+}
@@ -0,0 +1,313 @@
+package generator
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/r0bertson/goswag/internal/generator/testutil"
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type openAPIUser struct {
+	ID      string        `json:"id"`
+	Name    string        `json:"name"`
+	Friends []openAPIUser `json:"friends"`
+	Ignored string        `json:"-"`
+}
+
+func TestBuildOpenAPI(t *testing.T) {
+	routes := []Route{
+		{
+			Path:       "/users/:id",
+			Method:     http.MethodGet,
+			FuncName:   "getUser",
+			Summary:    "Get user",
+			PathParams: []Param{{Name: "id", ParamType: "string", Required: true, Pattern: "^[a-z0-9]+$"}},
+			QueryParams: []Param{
+				{Name: "verbose", ParamType: "boolean", Deprecated: true},
+				{Name: "limit", ParamType: "int", Default: "10", Enum: []string{"10", "50"}},
+				{Name: "fields", ParamType: "string", Array: true, CollectionFormat: "csv", Example: "id,name"},
+			},
+			Returns: []models.ReturnType{
+				{StatusCode: http.StatusOK, Body: openAPIUser{}},
+			},
+			Security: []string{"BearerAuth"},
+		},
+	}
+	groups := []Group{
+		{
+			GroupName: "/users",
+			Routes: []Route{
+				{
+					Path:     "/users",
+					Method:   http.MethodPost,
+					FuncName: "createUser",
+					Reads:    &openAPIUser{},
+					ReadFieldDescriptions: map[string]string{
+						"name": "User's name",
+					},
+					Returns: []models.ReturnType{
+						{StatusCode: http.StatusCreated},
+					},
+				},
+			},
+		},
+	}
+	defaultResponses := []models.ReturnType{{StatusCode: http.StatusBadRequest, Body: testutil.TestGeneric{}}}
+
+	doc := BuildOpenAPI(routes, groups, defaultResponses, nil)
+
+	assert.Equal(t, "3.1.0", doc.OpenAPI)
+
+	get := doc.Paths["/users/{id}"]["get"]
+	if assert.NotNil(t, get) {
+		assert.Equal(t, "getUser", get.OperationID)
+		assert.Equal(t, "Get user", get.Description)
+		assert.Equal(t, []Parameter{
+			{Name: "id", In: "path", Required: true, Schema: &Schema{Type: "string", Pattern: "^[a-z0-9]+$"}},
+			{Name: "verbose", In: "query", Deprecated: true, Schema: &Schema{Type: "boolean"}},
+			{Name: "limit", In: "query", Schema: &Schema{Type: "integer", Default: int64(10), Enum: []any{int64(10), int64(50)}}},
+			{Name: "fields", In: "query", Style: "form", Explode: new(bool), Schema: &Schema{Type: "array", Items: &Schema{Type: "string"}}, Example: []any{"id", "name"}},
+		}, get.Parameters)
+		assert.Equal(t, "#/components/schemas/generator.openAPIUser", get.Responses["200"].Content["application/json"].Schema.Ref)
+		assert.Equal(t, "#/components/schemas/testutil.TestGeneric", get.Responses["400"].Content["application/json"].Schema.Ref)
+		assert.Equal(t, []map[string][]string{{"BearerAuth": {}}}, get.Security)
+	}
+
+	post := doc.Paths["/users"]["post"]
+	if assert.NotNil(t, post) {
+		assert.Equal(t, []string{"/users"}, post.Tags)
+		assert.Equal(t, "Created", post.Responses["201"].Description)
+		assert.Nil(t, post.Responses["201"].Content)
+
+		body := post.RequestBody.Content["application/json"].Schema
+		assert.Empty(t, body.Ref)
+		assert.Equal(t, "User's name", body.Properties["name"].Description)
+	}
+
+	user := doc.Components.Schemas["generator.openAPIUser"]
+	if assert.NotNil(t, user) {
+		assert.Len(t, user.Properties, 3)
+		assert.Equal(t, "#/components/schemas/generator.openAPIUser", user.Properties["friends"].Items.Ref)
+		// the field description of the request must not leak into the component
+		assert.Empty(t, user.Properties["name"].Description)
+	}
+
+	_, err := json.Marshal(doc)
+	assert.NoError(t, err)
+}
+
+func TestBuildOpenAPI_overrideStructFields(t *testing.T) {
+	routes := []Route{
+		{
+			Path:   "/test",
+			Method: http.MethodGet,
+			Returns: []models.ReturnType{
+				{
+					StatusCode:           http.StatusOK,
+					Body:                 testutil.OverrideStruct{},
+					OverrideStructFields: map[string]interface{}{"body": testutil.TestGeneric{}},
+				},
+			},
+		},
+	}
+
+	doc := BuildOpenAPI(routes, nil, nil, nil)
+
+	schema := doc.Paths["/test"]["get"].Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, "#/components/schemas/testutil.TestGeneric", schema.Properties["body"].Ref)
+	assert.Equal(t, &Schema{}, doc.Components.Schemas["testutil.OverrideStruct"].Properties["body"])
+}
+
+func TestBuildOpenAPI_nestedGroupTags(t *testing.T) {
+	groups := []Group{
+		{
+			GroupName: "/api",
+			Routes:    []Route{{Path: "/api/health", Method: http.MethodGet}},
+			Groups: []Group{
+				{
+					GroupName: "/users",
+					Routes: []Route{
+						{Path: "/api/users", Method: http.MethodGet},
+						{Path: "/api/users", Method: http.MethodPost, Tags: []string{"admin"}},
+					},
+				},
+			},
+		},
+	}
+
+	doc := BuildOpenAPI(nil, groups, nil, nil)
+	assert.Equal(t, []string{"/api"}, doc.Paths["/api/health"]["get"].Tags)
+	assert.Equal(t, []string{"/users"}, doc.Paths["/api/users"]["get"].Tags)
+	assert.Equal(t, []string{"admin"}, doc.Paths["/api/users"]["post"].Tags)
+
+	swagger2 := BuildSwagger2(nil, groups, nil, nil)
+	assert.Equal(t, []string{"/users"}, swagger2.Paths["/api/users"]["get"].Tags)
+}
+
+func TestBuildOpenAPI_formParams(t *testing.T) {
+	maxLength := 100
+	routes := []Route{
+		{
+			Path:         "/users/:id/avatar",
+			Method:       http.MethodPut,
+			FuncName:     "uploadAvatar",
+			CookieParams: []Param{{Name: "session", ParamType: "string", Required: true}},
+			FormParams: []Param{
+				{Name: "caption", ParamType: "string", MaxLength: &maxLength},
+				{Name: "avatar", Description: "user avatar", ParamType: "file", Required: true, MimeTypes: []string{"image/png", "image/jpeg"}, MaxSize: 2048},
+			},
+		},
+	}
+
+	op := BuildOpenAPI(routes, nil, nil, nil).Paths["/users/{id}/avatar"]["put"]
+
+	assert.Contains(t, op.Parameters, Parameter{Name: "session", In: "cookie", Required: true, Schema: &Schema{Type: "string"}})
+	assert.Equal(t, &RequestBody{
+		Required: true,
+		Content: map[string]MediaType{
+			"multipart/form-data": {
+				Schema: &Schema{
+					Type: "object",
+					Properties: map[string]*Schema{
+						"caption": {Type: "string", MaxLength: &maxLength},
+						"avatar":  {Type: "string", Format: "binary", Description: "user avatar. Allowed types: image/png, image/jpeg. Maximum size: 2048 bytes."},
+					},
+					Required: []string{"avatar"},
+				},
+				Encoding: map[string]Encoding{"avatar": {ContentType: "image/png, image/jpeg"}},
+			},
+		},
+	}, op.RequestBody)
+}
+
+func TestBuild_formParamsAndReads(t *testing.T) {
+	routes := []Route{
+		{
+			Path:       "/users",
+			Method:     http.MethodPost,
+			FuncName:   "createUser",
+			Reads:      struct{ Name string }{},
+			FormParams: []Param{{Name: "name", ParamType: "string"}},
+		},
+	}
+
+	// the form is the body of the route, the strict generation reports the combination
+	body := BuildOpenAPI(routes, nil, nil, nil).Paths["/users"]["post"].RequestBody
+	assert.False(t, body.Required)
+	assert.Equal(t, []string{"application/x-www-form-urlencoded"}, slices.Collect(maps.Keys(body.Content)))
+
+	op := BuildSwagger2(routes, nil, nil, nil).Paths["/users"]["post"]
+	assert.Equal(t, []Swagger2Parameter{{Name: "name", In: "formData", Type: "string"}}, op.Parameters)
+
+	var s, wrappers strings.Builder
+	writeRoutes("", routes, &s, make(map[string]bool), &wrappers)
+	assert.NotContains(t, s.String(), "@Param request body")
+}
+
+func Test_schemaName(t *testing.T) {
+	tests := []struct {
+		name string
+		body interface{}
+		want string
+	}{
+		{
+			name: "Should return the package and struct name",
+			body: testutil.TestGeneric{},
+			want: "testutil.TestGeneric",
+		},
+		{
+			name: "Should remove the package path of generic types",
+			body: testutil.StructGeneric[testutil.TestGeneric]{},
+			want: "testutil.StructGeneric-testutil.TestGeneric",
+		},
+		{
+			name: "Should handle generic slices",
+			body: testutil.StructGeneric[[]testutil.TestGeneric]{},
+			want: "testutil.StructGeneric-array_testutil.TestGeneric",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, schemaName(reflect.TypeOf(tt.body)))
+		})
+	}
+}
+
+func TestOpenAPIPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/users", want: "/users"},
+		{path: "/users/:id", want: "/users/{id}"},
+		{path: "/users/:id/posts/:postID/", want: "/users/{id}/posts/{postID}/"},
+		{path: "/files/{path...}", want: "/files/{path}"},
+		{path: "/files/*path", want: "/files/{path}"},
+		{path: "/files/*", want: "/files/*"},
+		{path: "/users/{$}", want: "/users/"},
+		{path: "/users/{id}", want: "/users/{id}"},
+		{path: "/v1/{name}:generate", want: "/v1/{name}:generate"},
+		{path: "/files/a:b/:id", want: "/files/a:b/{id}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, OpenAPIPath(tt.path))
+		})
+	}
+}
+
+func TestPathTemplate(t *testing.T) {
+	tests := []struct {
+		path       string
+		want       string
+		wantParams []Param
+	}{
+		{path: "/users", want: "/users"},
+		{
+			path: "/users/:id/posts/{postID}",
+			want: "/users/{id}/posts/{postID}",
+			wantParams: []Param{
+				{Name: "id", ParamType: "string", Required: true},
+				{Name: "postID", ParamType: "string", Required: true},
+			},
+		},
+		{path: "/files/*path", want: "/files/{path}", wantParams: []Param{{Name: "path", ParamType: "string", Required: true}}},
+		{path: "/files/{path...}", want: "/files/{path}", wantParams: []Param{{Name: "path", ParamType: "string", Required: true}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			path, params := PathTemplate(tt.path)
+			assert.Equal(t, tt.want, path)
+			assert.Equal(t, tt.wantParams, params)
+		})
+	}
+}
+
+func TestSetParam(t *testing.T) {
+	params := []Param{{Name: "id", ParamType: "string", Required: true, Pattern: "^[0-9]+$"}}
+
+	params = SetParam(params, Param{Name: "id", Description: "user id", ParamType: "int", Required: true})
+	params = SetParam(params, Param{Name: "slug", ParamType: "string"})
+
+	assert.Equal(t, []Param{
+		{Name: "id", Description: "user id", ParamType: "int", Required: true, Pattern: "^[0-9]+$"},
+		{Name: "slug", ParamType: "string"},
+	}, params)
+}
+
+func Test_mimeType(t *testing.T) {
+	assert.Equal(t, "application/json", mimeType("json"))
+	assert.Equal(t, "multipart/form-data", mimeType("mpfd"))
+	assert.Equal(t, "image/png", mimeType("png"))
+	assert.Equal(t, "application/custom", mimeType("application/custom"))
+}
@@ -0,0 +1,448 @@
+package generator
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/r0bertson/goswag/models"
+)
+
+// paramLocations are the tags that declare a parameter, in the order they are looked up,
+// with the location of the parameter. form and uri are read by gin, query and param by echo.
+// The form fields are query parameters of the requests without body, and body fields otherwise.
+var paramLocations = []struct{ tag, in string }{
+	{"path", "path"},
+	{"uri", "path"},
+	{"param", "path"},
+	{"query", "query"},
+	{"form", "form"},
+	{"header", "header"},
+}
+
+// collectionSeparators are the separators of the values of an array parameter by collection format,
+// the values of the multi format are sent as repeated parameters.
+var collectionSeparators = map[string]string{
+	"":      ",",
+	"csv":   ",",
+	"ssv":   " ",
+	"tsv":   "\t",
+	"pipes": "|",
+	"multi": "",
+}
+
+// NewParam returns the parameter declared by QueryParam, HeaderParam, PathParam, CookieParam, FormParam
+// or FileParam with its options.
+func NewParam(name, description, paramType string, required bool, opts ...models.ParamOption) Param {
+	var o models.ParamOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return newParam(name, description, paramType, required, o)
+}
+
+func newParam(name, description, paramType string, required bool, o models.ParamOptions) Param {
+	return Param{
+		Name:             name,
+		Description:      description,
+		ParamType:        paramType,
+		Required:         required,
+		Pattern:          o.Pattern,
+		Default:          o.Default,
+		Enum:             o.Enum,
+		Example:          o.Example,
+		Format:           o.Format,
+		Minimum:          o.Minimum,
+		Maximum:          o.Maximum,
+		MinLength:        o.MinLength,
+		MaxLength:        o.MaxLength,
+		Array:            o.Array,
+		CollectionFormat: o.CollectionFormat,
+		Deprecated:       o.Deprecated,
+		MimeTypes:        o.MimeTypes,
+		MaxSize:          o.MaxSize,
+	}
+}
+
+// StructParams returns the path, query and header parameters declared by the tags of the fields
+// of v, a struct or a pointer to one, for a route of the method. The name is read from the path, uri
+// or param tags for path parameters, query or form for query parameters and header for header
+// parameters. The form fields are only query parameters for the methods without body, since gin
+// and echo bind them from the body of the POST, PUT and PATCH requests. The fields
+// without them are ignored and embedded structs are read as part of v. The type is inferred from
+// the field, slices are arrays sent as repeated parameters unless collectionFormat is set and
+// time.Time is a date-time string. The other tags are:
+//
+//	required:"true"             the parameter is required, path parameters always are
+//	doc:"..."                   the description of the parameter
+//	default:"1"                 the default value, also read from the default= option of the gin form tag
+//	enum:"a,b"                  the values accepted by the parameter
+//	example:"..."               an example value
+//	format:"uuid"               the format of the values
+//	minimum:"1" maximum:"100"   the bounds of a numeric parameter
+//	minLength:"1" maxLength:"5" the bounds of the length of a string parameter
+//	pattern:"^[a-z]+$"          a regular expression the values must match
+//	collectionFormat:"csv"      how the values of an array are sent
+//	deprecated:"true"           the parameter is deprecated
+func StructParams(v any, method string) (path, query, header []Param) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, nil, nil
+	}
+
+	structParams(t, func(in string, p Param) {
+		switch in {
+		case "path":
+			p.Required = true
+			path = SetParam(path, p)
+		case "query":
+			query = SetParam(query, p)
+		case "form":
+			if !hasBody(method) {
+				query = SetParam(query, p)
+			}
+		default:
+			header = SetParam(header, p)
+		}
+	})
+
+	return path, query, header
+}
+
+func structParams(t reflect.Type, add func(in string, p Param)) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		in, name, options, ok := ParamTag(f.Tag)
+		if !ok {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+
+			if f.Anonymous && ft.Kind() == reflect.Struct {
+				structParams(ft, add)
+			}
+
+			continue
+		}
+
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		paramType, array, format := fieldParamType(f.Type)
+		o := models.ParamOptions{
+			Default:          f.Tag.Get("default"),
+			Example:          f.Tag.Get("example"),
+			Format:           f.Tag.Get("format"),
+			Minimum:          floatTag(f.Tag, "minimum"),
+			Maximum:          floatTag(f.Tag, "maximum"),
+			MinLength:        intTag(f.Tag, "minLength"),
+			MaxLength:        intTag(f.Tag, "maxLength"),
+			Pattern:          f.Tag.Get("pattern"),
+			Array:            array,
+			CollectionFormat: f.Tag.Get("collectionFormat"),
+		}
+
+		o.Deprecated, _ = strconv.ParseBool(f.Tag.Get("deprecated"))
+		required, _ := strconv.ParseBool(f.Tag.Get("required"))
+
+		if o.Format == "" {
+			o.Format = format
+		}
+
+		if array && o.CollectionFormat == "" && (in == "query" || in == "form") {
+			// gin and echo bind the repeated query parameters to slices
+			o.CollectionFormat = "multi"
+		}
+
+		for _, option := range options {
+			if value, found := strings.CutPrefix(option, "default="); found && o.Default == "" {
+				o.Default = value
+			}
+		}
+
+		if enum := f.Tag.Get("enum"); enum != "" {
+			for _, value := range strings.Split(enum, ",") {
+				o.Enum = append(o.Enum, strings.TrimSpace(value))
+			}
+		}
+
+		add(in, newParam(name, f.Tag.Get("doc"), paramType, required, o))
+	}
+}
+
+// ParamTag returns the location (path, query, form or header), name and options of the first parameter tag of the field.
+func ParamTag(tag reflect.StructTag) (in, name string, options []string, ok bool) {
+	for _, location := range paramLocations {
+		value, found := tag.Lookup(location.tag)
+		if !found {
+			continue
+		}
+
+		parts := strings.Split(value, ",")
+		return location.in, strings.TrimSpace(parts[0]), parts[1:], true
+	}
+
+	return "", "", nil, false
+}
+
+func floatTag(tag reflect.StructTag, key string) *float64 {
+	f, err := strconv.ParseFloat(tag.Get(key), 64)
+	if err != nil {
+		return nil
+	}
+
+	return &f
+}
+
+func intTag(tag reflect.StructTag, key string) *int {
+	n, err := strconv.Atoi(tag.Get(key))
+	if err != nil {
+		return nil
+	}
+
+	return &n
+}
+
+// fieldParamType infers the parameter type of a field, if it is an array and its format.
+// Pointers have the type of their elements.
+func fieldParamType(t reflect.Type) (paramType string, array bool, format string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if (t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8) || t.Kind() == reflect.Array {
+		paramType, _, format = fieldParamType(t.Elem())
+		return paramType, true, format
+	}
+
+	if t == timeType {
+		return "string", false, "date-time"
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "int", false, ""
+	case reflect.Float32, reflect.Float64:
+		return "number", false, ""
+	case reflect.Bool:
+		return "boolean", false, ""
+	default:
+		return "string", false, ""
+	}
+}
+
+// hasBody reports whether the requests of the method have a body.
+func hasBody(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		return true
+	default:
+		return false
+	}
+}
+
+// paramValue converts a default, enum or example value to the type of the parameter.
+func paramValue(paramType, value string) (any, error) {
+	switch paramType {
+	case "int", "integer":
+		return strconv.ParseInt(value, 10, 64)
+	case "number":
+		return strconv.ParseFloat(value, 64)
+	case "bool", "boolean":
+		return strconv.ParseBool(value)
+	default:
+		return value, nil
+	}
+}
+
+// SplitParamValues returns the values of an array parameter, sent with the collection format,
+// or the value itself for the other parameters. The multi values are separated by commas.
+func SplitParamValues(p Param, value string) []string {
+	if !p.Array {
+		return []string{value}
+	}
+
+	sep := collectionSeparators[p.CollectionFormat]
+	if sep == "" {
+		sep = ","
+	}
+
+	return strings.Split(value, sep)
+}
+
+// paramAttributes returns the swag attributes written after the description of a parameter.
+// swag does not support the pattern nor the deprecation of parameters.
+func paramAttributes(p Param) string {
+	var s strings.Builder
+	attribute := func(name, value string) {
+		if value != "" {
+			s.WriteString(" " + name + "(" + value + ")")
+		}
+	}
+
+	attribute("default", p.Default)
+	attribute("Enums", strings.Join(p.Enum, ", "))
+	attribute("example", p.Example)
+	attribute("Format", p.Format)
+	attribute("minimum", formatFloat(p.Minimum))
+	attribute("maximum", formatFloat(p.Maximum))
+	attribute("minLength", formatInt(p.MinLength))
+	attribute("maxLength", formatInt(p.MaxLength))
+
+	if p.Array {
+		attribute("collectionFormat", p.CollectionFormat)
+	}
+
+	return s.String()
+}
+
+// swagParamType returns the type of the parameter in the swag comments, arrays are written as []type.
+func swagParamType(p Param) string {
+	if p.Array {
+		return "[]" + p.ParamType
+	}
+
+	return p.ParamType
+}
+
+func formatFloat(f *float64) string {
+	if f == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(*f, 'f', -1, 64)
+}
+
+func formatInt(n *int) string {
+	if n == nil {
+		return ""
+	}
+
+	return strconv.Itoa(*n)
+}
+
+// paramSchema returns the schema of the parameter with its constraints, the constraints of
+// an array apply to its items. The values that do not match the type are kept as strings.
+func paramSchema(p Param) *Schema {
+	schema := typeSchema(p.ParamType)
+	if p.Format != "" {
+		schema.Format = p.Format
+	}
+
+	schema.Pattern = p.Pattern
+	schema.Minimum, schema.Maximum = p.Minimum, p.Maximum
+	schema.MinLength, schema.MaxLength = p.MinLength, p.MaxLength
+
+	for _, e := range p.Enum {
+		schema.Enum = append(schema.Enum, convertParamValue(p, e))
+	}
+
+	if !p.Array {
+		if p.Default != "" {
+			schema.Default = convertParamValue(p, p.Default)
+		}
+
+		return schema
+	}
+
+	array := &Schema{Type: "array", Items: schema}
+	if p.Default != "" {
+		var def []any
+		for _, value := range SplitParamValues(p, p.Default) {
+			def = append(def, convertParamValue(p, value))
+		}
+
+		array.Default = def
+	}
+
+	return array
+}
+
+// paramExample returns the example of the parameter converted to its type, nil when there is none.
+func paramExample(p Param) any {
+	if p.Example == "" {
+		return nil
+	}
+
+	if !p.Array {
+		return convertParamValue(p, p.Example)
+	}
+
+	var example []any
+	for _, value := range SplitParamValues(p, p.Example) {
+		example = append(example, convertParamValue(p, value))
+	}
+
+	return example
+}
+
+func convertParamValue(p Param, value string) any {
+	if v, err := paramValue(p.ParamType, value); err == nil {
+		return v
+	}
+
+	return value
+}
+
+// paramDescription returns the description of the parameter, completed with the mime types and the maximum size of files.
+func paramDescription(p Param) string {
+	description := p.Description
+	add := func(text string) {
+		if description != "" && !strings.HasSuffix(description, ".") {
+			description += "."
+		}
+
+		description = strings.TrimSpace(description + " " + text)
+	}
+
+	if len(p.MimeTypes) > 0 {
+		add("Allowed types: " + strings.Join(p.MimeTypes, ", ") + ".")
+	}
+
+	if p.MaxSize > 0 {
+		add("Maximum size: " + strconv.FormatInt(p.MaxSize, 10) + " bytes.")
+	}
+
+	return description
+}
+
+// routeAccepts returns the content types consumed by the route. The form parameters force them to
+// multipart/form-data, the only one that can send files, or application/x-www-form-urlencoded.
+func routeAccepts(r Route) []string {
+	if len(r.FormParams) == 0 {
+		return r.Accepts
+	}
+
+	var form []string
+	for _, accept := range r.Accepts {
+		if m := mimeType(accept); m == "multipart/form-data" || m == "application/x-www-form-urlencoded" {
+			form = append(form, accept)
+		}
+	}
+
+	for _, p := range r.FormParams {
+		if p.ParamType == "file" {
+			return []string{"mpfd"}
+		}
+	}
+
+	if len(form) > 0 {
+		return form
+	}
+
+	return []string{"x-www-form-urlencoded"}
+}
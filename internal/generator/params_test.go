@@ -0,0 +1,206 @@
+package generator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type Pagination struct {
+	Page  int `query:"page" doc:"page number" default:"1"`
+	Limit int `form:"limit,default=20"`
+}
+
+type listParams struct {
+	Pagination
+	ID       string    `path:"id"`
+	Status   *string   `query:"status" enum:"active, blocked"`
+	Tags     []string  `query:"tags"`
+	Price    float64   `query:"price"`
+	Verbose  bool      `query:"verbose" required:"true"`
+	Since    time.Time `query:"since"`
+	IDs      []int     `query:"ids" collectionFormat:"csv" minimum:"1" maximum:"100"`
+	Email    string    `query:"email" format:"email" example:"john@example.com" minLength:"3" maxLength:"5" pattern:"@" deprecated:"true"`
+	Tenant   string    `header:"X-Tenant" required:"true" doc:"tenant id"`
+	Body     string    `json:"body"`
+	Ignored  string    `query:"-"`
+	internal string    `query:"internal"`
+}
+
+type frameworkParams struct {
+	GinID   int64  `uri:"gin_id"`
+	EchoID  uint   `param:"echo_id"`
+	Search  string `form:"q"`
+	Missing string `query:""`
+}
+
+func TestStructParams(t *testing.T) {
+	one, hundred := 1.0, 100.0
+	three, five := 3, 5
+
+	tests := []struct {
+		name       string
+		v          any
+		method     string
+		wantPath   []Param
+		wantQuery  []Param
+		wantHeader []Param
+	}{
+		{
+			name: "Should declare the params of the tagged fields",
+			v:    &listParams{},
+			wantPath: []Param{
+				{Name: "id", ParamType: "string", Required: true},
+			},
+			wantQuery: []Param{
+				{Name: "page", Description: "page number", ParamType: "int", Default: "1"},
+				{Name: "limit", ParamType: "int", Default: "20"},
+				{Name: "status", ParamType: "string", Enum: []string{"active", "blocked"}},
+				{Name: "tags", ParamType: "string", Array: true, CollectionFormat: "multi"},
+				{Name: "price", ParamType: "number"},
+				{Name: "verbose", ParamType: "boolean", Required: true},
+				{Name: "since", ParamType: "string", Format: "date-time"},
+				{Name: "ids", ParamType: "int", Array: true, CollectionFormat: "csv", Minimum: &one, Maximum: &hundred},
+				{Name: "email", ParamType: "string", Format: "email", Example: "john@example.com", MinLength: &three, MaxLength: &five, Pattern: "@", Deprecated: true},
+			},
+			wantHeader: []Param{
+				{Name: "X-Tenant", Description: "tenant id", ParamType: "string", Required: true},
+			},
+		},
+		{
+			name: "Should understand the gin and echo tags",
+			v:    frameworkParams{},
+			wantPath: []Param{
+				{Name: "gin_id", ParamType: "int", Required: true},
+				{Name: "echo_id", ParamType: "int", Required: true},
+			},
+			wantQuery: []Param{
+				{Name: "q", ParamType: "string"},
+				{Name: "Missing", ParamType: "string"},
+			},
+		},
+		{
+			name:   "Should not declare the form fields as query params of the methods with body",
+			v:      frameworkParams{},
+			method: "post",
+			wantPath: []Param{
+				{Name: "gin_id", ParamType: "int", Required: true},
+				{Name: "echo_id", ParamType: "int", Required: true},
+			},
+			wantQuery: []Param{
+				{Name: "Missing", ParamType: "string"},
+			},
+		},
+		{
+			name: "Should not declare params of values that are not structs",
+			v:    map[string]string{},
+		},
+		{
+			name: "Should not declare params of nil",
+			v:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, query, header := StructParams(tt.v, tt.method)
+
+			assert.Equal(t, tt.wantPath, path)
+			assert.Equal(t, tt.wantQuery, query)
+			assert.Equal(t, tt.wantHeader, header)
+		})
+	}
+}
+
+func TestNewParam(t *testing.T) {
+	limit := func(o *models.ParamOptions) { o.Default, o.Enum = "20", []string{"20", "50"} }
+	deprecated := func(o *models.ParamOptions) { o.Deprecated = true }
+
+	assert.Equal(t, Param{Name: "limit", Description: "page size", ParamType: "int", Default: "20", Enum: []string{"20", "50"}, Deprecated: true},
+		NewParam("limit", "page size", "int", false, limit, deprecated))
+	assert.Equal(t, Param{Name: "id", ParamType: "string", Required: true}, NewParam("id", "", "string", true))
+}
+
+func Test_paramSchema(t *testing.T) {
+	min, max := 1.0, 10.0
+	minLength := 2
+
+	tests := []struct {
+		name         string
+		param        Param
+		want         *Schema
+		wantExample  any
+		wantSwagType string
+		wantSwagAttr string
+	}{
+		{
+			name:         "Should convert the values to the type of the param",
+			param:        Param{ParamType: "int", Default: "5", Enum: []string{"5", "10"}, Example: "10", Minimum: &min, Maximum: &max},
+			want:         &Schema{Type: "integer", Default: int64(5), Enum: []any{int64(5), int64(10)}, Minimum: &min, Maximum: &max},
+			wantExample:  int64(10),
+			wantSwagType: "int",
+			wantSwagAttr: " default(5) Enums(5, 10) example(10) minimum(1) maximum(10)",
+		},
+		{
+			name:         "Should constrain the items of an array",
+			param:        Param{ParamType: "string", Format: "uuid", MinLength: &minLength, Array: true, CollectionFormat: "pipes", Default: "a|b", Example: "c"},
+			want:         &Schema{Type: "array", Items: &Schema{Type: "string", Format: "uuid", MinLength: &minLength}, Default: []any{"a", "b"}},
+			wantExample:  []any{"c"},
+			wantSwagType: "[]string",
+			wantSwagAttr: " default(a|b) example(c) Format(uuid) minLength(2) collectionFormat(pipes)",
+		},
+		{
+			name:         "Should keep the values that do not match the type as strings",
+			param:        Param{ParamType: "boolean", Default: "yes"},
+			want:         &Schema{Type: "boolean", Default: "yes"},
+			wantSwagType: "boolean",
+			wantSwagAttr: " default(yes)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, paramSchema(tt.param))
+			assert.Equal(t, tt.wantExample, paramExample(tt.param))
+			assert.Equal(t, tt.wantSwagType, swagParamType(tt.param))
+			assert.Equal(t, tt.wantSwagAttr, paramAttributes(tt.param))
+		})
+	}
+}
+
+func Test_routeAccepts(t *testing.T) {
+	tests := []struct {
+		name  string
+		route Route
+		want  []string
+	}{
+		{
+			name:  "Should keep the accepts of the routes without form params",
+			route: Route{Accepts: []string{"xml"}},
+			want:  []string{"xml"},
+		},
+		{
+			name:  "Should accept url encoded forms without files",
+			route: Route{Accepts: []string{"json"}, FormParams: []Param{{Name: "name", ParamType: "string"}}},
+			want:  []string{"x-www-form-urlencoded"},
+		},
+		{
+			name:  "Should keep the form accepts",
+			route: Route{Accepts: []string{"json", "mpfd"}, FormParams: []Param{{Name: "name", ParamType: "string"}}},
+			want:  []string{"mpfd"},
+		},
+		{
+			name:  "Should accept multipart forms with files",
+			route: Route{Accepts: []string{"x-www-form-urlencoded"}, FormParams: []Param{{Name: "avatar", ParamType: "file"}}},
+			want:  []string{"mpfd"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, routeAccepts(tt.route))
+		})
+	}
+}
@@ -0,0 +1,403 @@
+package generator
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schema is the JSON Schema representation of a Go type used by the native
+// document emitters.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Default              any                `json:"default,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	jsonNumberType    = reflect.TypeOf(json.Number(""))
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// SchemaBuilder builds JSON Schemas for Go types by reflection, following the
+// encoding/json rules. Named structs are kept as definitions and referenced
+// everywhere else, which is also how recursive types are represented.
+//
+// The schemas of the request bodies also describe the binding and validate tags of their fields,
+// the named structs with such tags get a distinct definition, e.g. UserRequest next to User.
+// Their fields are only required by the required tags, as encoding/json and the frameworks
+// accept missing fields.
+type SchemaBuilder struct {
+	refPrefix    string
+	definitions  map[string]*Schema
+	names        map[reflect.Type]string
+	requestNames map[reflect.Type]string
+	// rules caches whether the types have validation tags
+	rules map[reflect.Type]bool
+	// request is set while the schema of a request body is built
+	request bool
+	// requestsOnly is set when every struct is built as a request body, see NewRequestSchemaBuilder
+	requestsOnly bool
+}
+
+// NewSchemaBuilder returns a SchemaBuilder whose references start with the given prefix,
+// e.g. "#/components/schemas/" for OpenAPI 3 or "#/definitions/" for Swagger 2.0.
+func NewSchemaBuilder(refPrefix string) *SchemaBuilder {
+	return &SchemaBuilder{
+		refPrefix:    refPrefix,
+		definitions:  make(map[string]*Schema),
+		names:        make(map[reflect.Type]string),
+		requestNames: make(map[reflect.Type]string),
+		rules:        make(map[reflect.Type]bool),
+	}
+}
+
+// NewRequestSchemaBuilder returns a SchemaBuilder for request bodies only: the structs without
+// validation tags do not share the definitions of the responses, so none of their fields are required.
+func NewRequestSchemaBuilder(refPrefix string) *SchemaBuilder {
+	b := NewSchemaBuilder(refPrefix)
+	b.requestsOnly = true
+
+	return b
+}
+
+// Definitions returns the schemas of the named structs referenced by the built schemas.
+func (b *SchemaBuilder) Definitions() map[string]*Schema {
+	return b.definitions
+}
+
+// Schema returns the schema of the given value, nil values have no schema.
+func (b *SchemaBuilder) Schema(v interface{}) *Schema {
+	if v == nil {
+		return nil
+	}
+
+	return b.TypeSchema(reflect.TypeOf(v))
+}
+
+// RequestSchema returns the schema of the given request body, with the constraints of the
+// validation tags of its fields. nil values have no schema.
+func (b *SchemaBuilder) RequestSchema(v interface{}) *Schema {
+	b.request = true
+	defer func() { b.request = false }()
+
+	return b.Schema(v)
+}
+
+// TypeSchema returns the schema of the given type.
+func (b *SchemaBuilder) TypeSchema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t == rawMessageType:
+		// raw messages can hold any json value
+		return &Schema{}
+	case t == jsonNumberType:
+		return &Schema{Type: "number"}
+	case implements(t, jsonMarshalerType):
+		// custom json marshalers can produce anything
+		return &Schema{}
+	case implements(t, textMarshalerType):
+		// encoding/json uses the text representation, e.g. uuids and ip addresses
+		return &Schema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Uint, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer"}
+	case reflect.Int32, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && !implements(t.Elem(), textMarshalerType) {
+			// encoding/json encodes byte slices as base64 strings
+			return &Schema{Type: "string", Format: "byte"}
+		}
+
+		return &Schema{Type: "array", Items: b.TypeSchema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: b.TypeSchema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.structSchema(t)
+		}
+
+		return b.refSchema(t)
+	default:
+		// interfaces and everything else accept any value
+		return &Schema{}
+	}
+}
+
+// Resolve returns the definition behind a reference or the schema itself.
+func (b *SchemaBuilder) Resolve(s *Schema) *Schema {
+	if s == nil || s.Ref == "" {
+		return s
+	}
+
+	return b.definitions[strings.TrimPrefix(s.Ref, b.refPrefix)]
+}
+
+// refSchema registers the named struct as a definition and returns a reference to it.
+func (b *SchemaBuilder) refSchema(t reflect.Type) *Schema {
+	names, base := b.names, schemaName(t)
+	if b.request {
+		if b.requestsOnly || b.hasRules(t) {
+			names, base = b.requestNames, base+"Request"
+		} else {
+			// without validation tags the request schema is the same as the response one
+			b.request = false
+			defer func() { b.request = true }()
+		}
+	}
+
+	name, ok := names[t]
+	if !ok {
+		name = b.uniqueName(base)
+		names[t] = name
+		// the name is registered and reserved before the fields are walked, so a type
+		// referencing itself ends up pointing to its own definition
+		b.definitions[name] = nil
+		b.definitions[name] = b.structSchema(t)
+	}
+
+	return &Schema{Ref: b.refPrefix + name}
+}
+
+// uniqueName returns the schema name, suffixed with a number when a type with the same name
+// from another package is already defined, e.g. a/models.User and b/models.User.
+func (b *SchemaBuilder) uniqueName(base string) string {
+	name := base
+	for i := 2; b.taken(name); i++ {
+		name = base + "_" + strconv.Itoa(i)
+	}
+
+	return name
+}
+
+func (b *SchemaBuilder) taken(name string) bool {
+	_, ok := b.definitions[name]
+	return ok
+}
+
+func (b *SchemaBuilder) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	b.addFields(s, t, make(map[reflect.Type]bool))
+
+	if len(s.Properties) == 0 {
+		s.Properties = nil
+	}
+
+	return s
+}
+
+// addFields adds the json fields of the struct to the schema.
+// Fields of embedded structs without a json name are promoted to the parent, as encoding/json does,
+// and fields declared in the outer struct win over the promoted ones.
+func (b *SchemaBuilder) addFields(s *Schema, t reflect.Type, visited map[reflect.Type]bool) {
+	visited[t] = true
+
+	var embedded []reflect.Type
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		name, opts := jsonFieldName(field)
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && !hasJSONName(field) {
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+
+			if fieldType.Kind() == reflect.Struct {
+				embedded = append(embedded, fieldType)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if _, ok := s.Properties[name]; ok {
+			continue
+		}
+
+		schema := b.TypeSchema(field.Type)
+		if hasOption(opts, "string") && isScalar(field.Type) {
+			// the ",string" option encodes numbers and booleans as json strings
+			schema = &Schema{Type: "string"}
+		}
+
+		// the validation tags of the requests constrain the values and are the only ones requiring
+		// fields, the responses always have the fields that are not pointers or omitted when empty
+		var required bool
+		if b.request {
+			required = applyRules(schema, field.Type, validationRules(field))
+		} else {
+			required = field.Type.Kind() != reflect.Ptr && !hasOption(opts, "omitempty") && !hasOption(opts, "omitzero")
+		}
+
+		s.Properties[name] = schema
+
+		if required {
+			s.Required = append(s.Required, name)
+		}
+	}
+
+	for _, e := range embedded {
+		if visited[e] {
+			continue
+		}
+
+		b.addFields(s, e, visited)
+	}
+}
+
+// hasRules reports whether the fields of the type, or of the types it contains, have validation tags.
+func (b *SchemaBuilder) hasRules(t reflect.Type) bool {
+	rules, ok := b.rules[t]
+	if !ok {
+		rules = typeHasRules(t, make(map[reflect.Type]bool))
+		b.rules[t] = rules
+	}
+
+	return rules
+}
+
+func typeHasRules(t reflect.Type, visited map[reflect.Type]bool) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if visited[t] {
+		return false
+	}
+
+	visited[t] = true
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return typeHasRules(t.Elem(), visited)
+	case reflect.Struct:
+		if t == timeType || implements(t, jsonMarshalerType) || implements(t, textMarshalerType) {
+			return false
+		}
+
+		for i := 0; i < t.NumField(); i++ {
+			if len(validationRules(t.Field(i))) > 0 || typeHasRules(t.Field(i).Type, visited) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hasJSONName reports whether the json tag of the field defines a name.
+func hasJSONName(field reflect.StructField) bool {
+	return strings.Split(field.Tag.Get("json"), ",")[0] != ""
+}
+
+func implements(t, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PointerTo(t).Implements(iface)
+}
+
+func isScalar(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+func hasOption(opts []string, option string) bool {
+	for _, opt := range opts {
+		if strings.TrimSpace(opt) == option {
+			return true
+		}
+	}
+
+	return false
+}
+
+// jsonFieldName returns the name used by encoding/json for the field and the
+// remaining options of the json tag.
+func jsonFieldName(field reflect.StructField) (string, []string) {
+	jsonTag := field.Tag.Get("json")
+	if jsonTag == "-" {
+		return "-", nil
+	}
+
+	parts := strings.Split(jsonTag, ",")
+	if parts[0] == "" {
+		return field.Name, parts[1:]
+	}
+
+	return parts[0], parts[1:]
+}
+
+var (
+	packagePathRegex   = regexp.MustCompile(`[\w\.\-~]+/`)
+	invalidSchemaChars = regexp.MustCompile(`[^a-zA-Z0-9\.\-_]`)
+)
+
+// schemaName returns a component name for the type that is valid in both
+// OpenAPI and Swagger documents.
+// example: testutil.StructGeneric[github.com/r0bertson/goswag/internal/generator/testutil.TestGeneric]
+// becomes testutil.StructGeneric-testutil.TestGeneric
+func schemaName(t reflect.Type) string {
+	name := packagePathRegex.ReplaceAllString(t.String(), "")
+	name = strings.ReplaceAll(name, "[]", "array_")
+	name = strings.ReplaceAll(name, "[", "-")
+	name = strings.ReplaceAll(name, ",", "-")
+	name = strings.ReplaceAll(name, "]", "")
+
+	return invalidSchemaChars.ReplaceAllString(name, "_")
+}
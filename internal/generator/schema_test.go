@@ -0,0 +1,222 @@
+package generator
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/r0bertson/goswag/internal/generator/testutil"
+	othertestutil "github.com/r0bertson/goswag/internal/generator/testutil/other"
+	"github.com/stretchr/testify/assert"
+)
+
+type schemaBase struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type schemaNode struct {
+	Value    int           `json:"value"`
+	Parent   *schemaNode   `json:"parent,omitempty"`
+	Children []*schemaNode `json:"children"`
+}
+
+type schemaUser struct {
+	schemaBase
+	Name     string            `json:"name"`
+	Nickname *string           `json:"nickname"`
+	Age      int               `json:"age,omitempty"`
+	Count    int64             `json:"count,string"`
+	Labels   map[string]string `json:"labels"`
+	Avatar   []byte            `json:"avatar"`
+	Extra    json.RawMessage   `json:"extra"`
+	IP       net.IP            `json:"ip"`
+	Meta     interface{}       `json:"meta"`
+	Skipped  string            `json:"-"`
+	NoTag    bool
+	private  string
+}
+
+func TestSchemaBuilder_Schema(t *testing.T) {
+	tests := []struct {
+		name string
+		body interface{}
+		want *Schema
+	}{
+		{
+			name: "Should return nil for nil values",
+			body: nil,
+			want: nil,
+		},
+		{
+			name: "Should return primitive types",
+			body: float32(1),
+			want: &Schema{Type: "number", Format: "float"},
+		},
+		{
+			name: "Should return arrays of the element schema",
+			body: []int32{},
+			want: &Schema{Type: "array", Items: &Schema{Type: "integer", Format: "int32"}},
+		},
+		{
+			name: "Should return time.Time as date-time strings",
+			body: &time.Time{},
+			want: &Schema{Type: "string", Format: "date-time"},
+		},
+		{
+			name: "Should return text marshalers as strings",
+			body: net.IP{},
+			want: &Schema{Type: "string"},
+		},
+		{
+			name: "Should inline anonymous structs",
+			body: struct {
+				Name string `json:"name,omitempty"`
+			}{},
+			want: &Schema{Type: "object", Properties: map[string]*Schema{"name": {Type: "string"}}},
+		},
+		{
+			name: "Should reference named structs",
+			body: schemaBase{},
+			want: &Schema{Ref: "#/definitions/generator.schemaBase"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewSchemaBuilder("#/definitions/")
+			assert.Equal(t, tt.want, b.Schema(tt.body))
+		})
+	}
+}
+
+func TestSchemaBuilder_structFields(t *testing.T) {
+	b := NewSchemaBuilder("#/components/schemas/")
+
+	ref := b.Schema(schemaUser{})
+	user := b.Resolve(ref)
+
+	assert.Equal(t, "#/components/schemas/generator.schemaUser", ref.Ref)
+	assert.Equal(t, &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"id":         {Type: "string"},
+			"created_at": {Type: "string", Format: "date-time"},
+			"name":       {Type: "string"},
+			"nickname":   {Type: "string"},
+			"age":        {Type: "integer"},
+			"count":      {Type: "string"},
+			"labels":     {Type: "object", AdditionalProperties: &Schema{Type: "string"}},
+			"avatar":     {Type: "string", Format: "byte"},
+			"extra":      {},
+			"ip":         {Type: "string"},
+			"meta":       {},
+			"NoTag":      {Type: "boolean"},
+		},
+		Required: []string{"name", "count", "labels", "avatar", "extra", "ip", "meta", "NoTag", "id", "created_at"},
+	}, user)
+	// embedded structs are promoted, so they are not referenced as definitions
+	assert.NotContains(t, b.Definitions(), "generator.schemaBase")
+}
+
+type schemaSignup struct {
+	Email    string            `json:"email" binding:"required,email"`
+	Name     string            `json:"name,omitempty" validate:"required,min=2,max=50"`
+	Age      *int              `json:"age" binding:"omitempty,gte=18,lte=130"`
+	Plan     string            `json:"plan" binding:"oneof=free pro 'pro plus'"`
+	Level    int               `json:"level" validate:"oneof=1 2 3"`
+	Code     string            `json:"code" validate:"len=6"`
+	Website  string            `json:"website,omitempty" validate:"omitempty,url|uri"`
+	Tags     []string          `json:"tags,omitempty" binding:"required,min=1,max=5,dive,min=2"`
+	Scores   map[string]int    `json:"scores,omitempty" validate:"dive,keys,min=1,endkeys,max=10"`
+	Address  schemaAddressRule `json:"address" binding:"required,min=1"`
+	Password string            `json:"password" binding:"-"`
+}
+
+type schemaAddressRule struct {
+	City string `json:"city" binding:"required"`
+}
+
+func TestSchemaBuilder_validationTags(t *testing.T) {
+	b := NewSchemaBuilder("#/definitions/")
+
+	ref := b.RequestSchema(schemaSignup{})
+	signup := b.Resolve(ref)
+
+	one, two, five, six, fifty := 1, 2, 5, 6, 50
+	ten, eighteen, oneThirty := 10.0, 18.0, 130.0
+	assert.Equal(t, &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"email":    {Type: "string", Format: "email"},
+			"name":     {Type: "string", MinLength: &two, MaxLength: &fifty},
+			"age":      {Type: "integer", Minimum: &eighteen, Maximum: &oneThirty},
+			"plan":     {Type: "string", Enum: []any{"free", "pro", "pro plus"}},
+			"level":    {Type: "integer", Enum: []any{int64(1), int64(2), int64(3)}},
+			"code":     {Type: "string", MinLength: &six, MaxLength: &six},
+			"website":  {Type: "string"},
+			"tags":     {Type: "array", Items: &Schema{Type: "string", MinLength: &two}, MinItems: &one, MaxItems: &five},
+			"scores":   {Type: "object", AdditionalProperties: &Schema{Type: "integer", Maximum: &ten}},
+			"address":  {Ref: "#/definitions/generator.schemaAddressRuleRequest"},
+			"password": {Type: "string"},
+		},
+		Required: []string{"email", "name", "tags", "address"},
+	}, signup)
+	assert.Equal(t, "#/definitions/generator.schemaSignupRequest", ref.Ref)
+	assert.Equal(t, []string{"city"}, b.Definitions()["generator.schemaAddressRuleRequest"].Required)
+
+	// the responses are not constrained by the validation tags
+	response := b.Resolve(b.Schema(schemaSignup{}))
+	assert.Equal(t, &Schema{Type: "string"}, response.Properties["email"])
+	assert.Equal(t, &Schema{Ref: "#/definitions/generator.schemaAddressRule"}, response.Properties["address"])
+	assert.Equal(t, []string{"email", "plan", "level", "code", "address", "password"}, response.Required)
+
+	// the requests without validation tags share the definitions of the responses
+	assert.Equal(t, b.Schema(schemaNode{}), b.RequestSchema(schemaNode{}))
+}
+
+func TestSchemaBuilder_recursiveTypes(t *testing.T) {
+	b := NewSchemaBuilder("#/components/schemas/")
+
+	b.Schema(schemaNode{})
+
+	node := b.Definitions()["generator.schemaNode"]
+	if assert.NotNil(t, node) {
+		assert.Equal(t, "#/components/schemas/generator.schemaNode", node.Properties["parent"].Ref)
+		assert.Equal(t, "#/components/schemas/generator.schemaNode", node.Properties["children"].Items.Ref)
+		assert.Equal(t, []string{"value", "children"}, node.Required)
+	}
+	assert.Len(t, b.Definitions(), 1)
+}
+
+func TestSchemaBuilder_sameNameTypes(t *testing.T) {
+	b := NewSchemaBuilder("#/definitions/")
+
+	first := b.Schema(testutil.TestGeneric{})
+	second := b.Schema(othertestutil.TestGeneric{})
+
+	assert.Equal(t, "#/definitions/testutil.TestGeneric", first.Ref)
+	assert.Equal(t, "#/definitions/testutil.TestGeneric_2", second.Ref)
+	assert.Equal(t, &Schema{Type: "string"}, b.Resolve(first).Properties["Name"])
+	assert.Equal(t, &Schema{Type: "integer"}, b.Resolve(second).Properties["ID"])
+	assert.Equal(t, first, b.Schema(&testutil.TestGeneric{}))
+	assert.Len(t, b.Definitions(), 2)
+}
+
+func TestSchemaBuilder_embeddedShadowing(t *testing.T) {
+	type inner struct {
+		Name  string `json:"name"`
+		Inner string `json:"inner"`
+	}
+	type outer struct {
+		*inner
+		Name int `json:"name"`
+	}
+
+	b := NewSchemaBuilder("#/definitions/")
+	s := b.Resolve(b.Schema(outer{}))
+
+	assert.Equal(t, &Schema{Type: "integer"}, s.Properties["name"])
+	assert.Equal(t, &Schema{Type: "string"}, s.Properties["inner"])
+}
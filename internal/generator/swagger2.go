@@ -0,0 +1,313 @@
+package generator
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/r0bertson/goswag/models"
+)
+
+const (
+	swagger2JSONFileName = "swagger.json"
+	swagger2YAMLFileName = "swagger.yaml"
+	swagger2Version      = "2.0"
+	swagger2RefPrefix    = "#/definitions/"
+)
+
+// Swagger2Document is the root of a Swagger 2.0 document.
+type Swagger2Document struct {
+	Swagger             string                             `json:"swagger"`
+	Info                DocumentInfo                       `json:"info"`
+	Host                string                             `json:"host,omitempty"`
+	BasePath            string                             `json:"basePath,omitempty"`
+	Schemes             []string                           `json:"schemes,omitempty"`
+	Paths               map[string]Swagger2PathItem        `json:"paths"`
+	Definitions         map[string]*Schema                 `json:"definitions,omitempty"`
+	SecurityDefinitions map[string]*Swagger2SecurityScheme `json:"securityDefinitions,omitempty"`
+}
+
+// Swagger2PathItem holds the operations of a path keyed by the lower case http method.
+type Swagger2PathItem map[string]*Swagger2Operation
+
+type Swagger2Operation struct {
+	Tags        []string                     `json:"tags,omitempty"`
+	Summary     string                       `json:"summary,omitempty"`
+	Description string                       `json:"description,omitempty"`
+	OperationID string                       `json:"operationId,omitempty"`
+	Consumes    []string                     `json:"consumes,omitempty"`
+	Produces    []string                     `json:"produces,omitempty"`
+	Parameters  []Swagger2Parameter          `json:"parameters,omitempty"`
+	Responses   map[string]*Swagger2Response `json:"responses"`
+	Security    []map[string][]string        `json:"security,omitempty"`
+}
+
+// Swagger2Parameter is a parameter of an operation, the body parameter has a schema
+// and the other ones a type.
+type Swagger2Parameter struct {
+	Name             string   `json:"name"`
+	In               string   `json:"in"`
+	Description      string   `json:"description,omitempty"`
+	Required         bool     `json:"required,omitempty"`
+	Type             string   `json:"type,omitempty"`
+	Format           string   `json:"format,omitempty"`
+	Items            *Schema  `json:"items,omitempty"`
+	CollectionFormat string   `json:"collectionFormat,omitempty"`
+	Default          any      `json:"default,omitempty"`
+	Minimum          *float64 `json:"minimum,omitempty"`
+	Maximum          *float64 `json:"maximum,omitempty"`
+	MinLength        *int     `json:"minLength,omitempty"`
+	MaxLength        *int     `json:"maxLength,omitempty"`
+	Pattern          string   `json:"pattern,omitempty"`
+	Enum             []any    `json:"enum,omitempty"`
+	Schema           *Schema  `json:"schema,omitempty"`
+}
+
+type Swagger2Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+type Swagger2SecurityScheme struct {
+	Type             string            `json:"type"`
+	Description      string            `json:"description,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	In               string            `json:"in,omitempty"`
+	Flow             string            `json:"flow,omitempty"`
+	AuthorizationURL string            `json:"authorizationUrl,omitempty"`
+	TokenURL         string            `json:"tokenUrl,omitempty"`
+	Scopes           map[string]string `json:"scopes,omitempty"`
+}
+
+func generateSwagger2(routes []Route, groups []Group, defaultResponses []models.ReturnType, opts models.GenerateOptions) error {
+	defaultFileName := swagger2JSONFileName
+	if opts.Format == models.FormatSwagger2YAML {
+		defaultFileName = swagger2YAMLFileName
+	}
+
+	filePath := outputPath(opts, defaultFileName)
+	logf(opts, "Generating %s file...", filePath)
+
+	content, err := MarshalSwagger2(routes, groups, defaultResponses, opts.Info)
+	if err != nil {
+		return &models.OutputError{Path: filePath, Err: err}
+	}
+
+	if opts.Format == models.FormatSwagger2YAML {
+		if content, err = JSONToYAML(content); err != nil {
+			return &models.OutputError{Path: filePath, Err: err}
+		}
+	}
+
+	if err := writeOutput(filePath, content, opts); err != nil {
+		return err
+	}
+
+	logf(opts, "%s file generated successfully!", filePath)
+
+	return nil
+}
+
+// MarshalSwagger2 returns the indented json of the Swagger 2.0 document built from the routes and groups.
+func MarshalSwagger2(routes []Route, groups []Group, defaultResponses []models.ReturnType, info *models.Info) ([]byte, error) {
+	return json.MarshalIndent(BuildSwagger2(routes, groups, defaultResponses, info), "", "  ")
+}
+
+// BuildSwagger2 builds a Swagger 2.0 document from the routes and groups.
+// The schemas of the bodies are built as for the OpenAPI document and referenced from #/definitions.
+// The general info is optional.
+func BuildSwagger2(routes []Route, groups []Group, defaultResponses []models.ReturnType, info *models.Info) *Swagger2Document {
+	host, basePath, schemes := hostAndBasePath(info)
+	b := &swagger2Builder{
+		doc: &Swagger2Document{
+			Swagger:  swagger2Version,
+			Info:     documentInfo(info),
+			Host:     host,
+			BasePath: basePath,
+			Schemes:  schemes,
+			Paths:    make(map[string]Swagger2PathItem),
+		},
+		schemas:          NewSchemaBuilder(swagger2RefPrefix),
+		defaultResponses: defaultResponses,
+		operationIDs:     make(operationIDs),
+	}
+
+	b.addRoutes(nil, routes)
+	b.addGroups(nil, groups)
+
+	if len(b.schemas.Definitions()) > 0 {
+		b.doc.Definitions = b.schemas.Definitions()
+	}
+
+	for name, scheme := range securitySchemes(info) {
+		if b.doc.SecurityDefinitions == nil {
+			b.doc.SecurityDefinitions = make(map[string]*Swagger2SecurityScheme)
+		}
+
+		b.doc.SecurityDefinitions[name] = swagger2SecurityScheme(scheme)
+	}
+
+	return b.doc
+}
+
+type swagger2Builder struct {
+	doc              *Swagger2Document
+	schemas          *SchemaBuilder
+	defaultResponses []models.ReturnType
+	operationIDs     operationIDs
+}
+
+func (b *swagger2Builder) addGroups(parentTags []string, groups []Group) {
+	for _, g := range groups {
+		tags := groupTags(parentTags, g)
+		b.addRoutes(tags, g.Routes)
+		b.addGroups(tags, g.Groups)
+	}
+}
+
+func (b *swagger2Builder) addRoutes(groupTags []string, routes []Route) {
+	for _, r := range routes {
+		if r.Path == "" || r.Method == "" {
+			continue
+		}
+
+		path := OpenAPIPath(r.Path)
+
+		item, ok := b.doc.Paths[path]
+		if !ok {
+			item = make(Swagger2PathItem)
+			b.doc.Paths[path] = item
+		}
+
+		item[strings.ToLower(r.Method)] = b.operation(groupTags, r)
+	}
+}
+
+func (b *swagger2Builder) operation(groupTags []string, r Route) *Swagger2Operation {
+	op := &Swagger2Operation{
+		Summary:     r.Summary,
+		Description: r.Description,
+		OperationID: b.operationIDs.next(routeFuncName(r)),
+		Responses:   make(map[string]*Swagger2Response),
+	}
+
+	if op.Description == "" {
+		op.Description = r.Summary
+	}
+
+	if len(r.Tags) > 0 {
+		op.Tags = r.Tags
+	} else if len(groupTags) > 0 {
+		op.Tags = groupTags
+	}
+
+	op.Parameters = append(op.Parameters, toSwagger2Parameters("path", r.PathParams)...)
+	op.Parameters = append(op.Parameters, toSwagger2Parameters("query", r.QueryParams)...)
+	op.Parameters = append(op.Parameters, toSwagger2Parameters("header", r.HeaderParams)...)
+	// Swagger 2.0 does not support cookie parameters
+	op.Parameters = append(op.Parameters, toSwagger2Parameters("formData", r.FormParams)...)
+
+	if body := r.body(); body != nil {
+		op.Parameters = append(op.Parameters, Swagger2Parameter{
+			Name:        "request",
+			In:          "body",
+			Description: "Request",
+			Required:    true,
+			Schema:      bodySchema(b.schemas, b.schemas.RequestSchema(body), r.ReadFieldDescriptions, nil),
+		})
+	}
+
+	hasResponseBody := false
+	returns := append(append([]models.ReturnType{}, r.responses()...), b.defaultResponses...)
+	for _, data := range returns {
+		if data.StatusCode == 0 {
+			continue
+		}
+
+		resp := &Swagger2Response{Description: http.StatusText(data.StatusCode)}
+		if data.Body != nil {
+			resp.Schema = bodySchema(b.schemas, b.schemas.Schema(data.Body), data.FieldDescriptions, data.OverrideStructFields)
+			hasResponseBody = true
+		}
+
+		op.Responses[strconv.Itoa(data.StatusCode)] = resp
+	}
+
+	if len(op.Responses) == 0 {
+		op.Responses["default"] = &Swagger2Response{Description: "Default response"}
+	}
+
+	if len(r.Accepts) > 0 || r.body() != nil || len(r.FormParams) > 0 {
+		op.Consumes = mimeTypes(routeAccepts(r))
+	}
+
+	if len(r.Produces) > 0 || hasResponseBody {
+		op.Produces = mimeTypes(r.Produces)
+	}
+
+	for _, scheme := range r.Security {
+		if strings.TrimSpace(scheme) == "" {
+			continue
+		}
+
+		op.Security = append(op.Security, map[string][]string{scheme: {}})
+	}
+
+	return op
+}
+
+// toSwagger2Parameters maps the params to Swagger 2.0, which does not support their deprecation nor examples.
+func toSwagger2Parameters(in string, params []Param) []Swagger2Parameter {
+	var parameters []Swagger2Parameter
+	for _, p := range params {
+		schema := paramSchema(p)
+		parameter := Swagger2Parameter{
+			Name:        p.Name,
+			In:          in,
+			Description: paramDescription(p),
+			// path parameters are always required in Swagger 2.0
+			Required:  p.Required || in == "path",
+			Type:      schema.Type,
+			Format:    schema.Format,
+			Default:   schema.Default,
+			Minimum:   schema.Minimum,
+			Maximum:   schema.Maximum,
+			MinLength: schema.MinLength,
+			MaxLength: schema.MaxLength,
+			Pattern:   schema.Pattern,
+			Enum:      schema.Enum,
+		}
+
+		if p.Array {
+			parameter.Items = schema.Items
+			parameter.CollectionFormat = p.CollectionFormat
+		}
+
+		// files have their own type in Swagger 2.0
+		switch {
+		case p.ParamType == "file" && p.Array:
+			parameter.Items = &Schema{Type: "file"}
+		case p.ParamType == "file":
+			parameter.Type, parameter.Format = "file", ""
+		}
+
+		parameters = append(parameters, parameter)
+	}
+
+	return parameters
+}
+
+// mimeTypes returns the full mime types of the swag aliases, json when there is none.
+func mimeTypes(aliases []string) []string {
+	if len(aliases) == 0 || strings.TrimSpace(aliases[0]) == "" {
+		return []string{mimeType("json")}
+	}
+
+	types := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		types = append(types, mimeType(alias))
+	}
+
+	return types
+}
@@ -0,0 +1,112 @@
+package generator
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildSwagger2(t *testing.T) {
+	routes := []Route{
+		{
+			Path:       "/users/:id",
+			Method:     http.MethodGet,
+			FuncName:   "getUser",
+			Summary:    "Get user",
+			PathParams: []Param{{Name: "id", ParamType: "int", Required: true, Pattern: "^[0-9]+$"}},
+			QueryParams: []Param{
+				{Name: "sort", ParamType: "string", Default: "asc", Enum: []string{"asc", "desc"}},
+				{Name: "ids", ParamType: "int", Format: "int64", Array: true, CollectionFormat: "multi"},
+			},
+			HeaderParams: []Param{{Name: "X-Tenant", ParamType: "string"}},
+			Produces:     []string{"json", "xml"},
+			Returns:      []models.ReturnType{{StatusCode: http.StatusOK, Body: openAPIUser{}}},
+			Security:     []string{"BearerAuth"},
+		},
+	}
+	groups := []Group{
+		{
+			GroupName: "/users",
+			Routes: []Route{
+				{
+					Path:     "/users",
+					Method:   http.MethodPost,
+					FuncName: "createUser",
+					Reads:    openAPIUser{},
+					Accepts:  []string{"json"},
+					Returns:  []models.ReturnType{{StatusCode: http.StatusCreated}},
+					Security: []string{"BasicAuth", "BearerAuth"},
+				},
+			},
+		},
+	}
+
+	info := &models.Info{SecuritySchemes: map[string]models.SecurityScheme{
+		"BearerAuth": {Type: models.SecurityBearer},
+		"BasicAuth":  {Type: models.SecurityBasic},
+	}}
+	doc := BuildSwagger2(routes, groups, nil, info)
+
+	assert.Equal(t, "2.0", doc.Swagger)
+
+	get := doc.Paths["/users/{id}"]["get"]
+	assert.Equal(t, "getUser", get.OperationID)
+	assert.Equal(t, []Swagger2Parameter{
+		{Name: "id", In: "path", Required: true, Type: "integer", Pattern: "^[0-9]+$"},
+		{Name: "sort", In: "query", Type: "string", Default: "asc", Enum: []any{"asc", "desc"}},
+		{Name: "ids", In: "query", Type: "array", Items: &Schema{Type: "integer", Format: "int64"}, CollectionFormat: "multi"},
+		{Name: "X-Tenant", In: "header", Type: "string"},
+	}, get.Parameters)
+	assert.Nil(t, get.Consumes)
+	assert.Equal(t, []string{"application/json", "application/xml"}, get.Produces)
+	assert.Equal(t, &Schema{Ref: "#/definitions/generator.openAPIUser"}, get.Responses["200"].Schema)
+	assert.Equal(t, []map[string][]string{{"BearerAuth": {}}}, get.Security)
+
+	post := doc.Paths["/users"]["post"]
+	assert.Equal(t, []string{"/users"}, post.Tags)
+	assert.Equal(t, []Swagger2Parameter{
+		{Name: "request", In: "body", Description: "Request", Required: true, Schema: &Schema{Ref: "#/definitions/generator.openAPIUser"}},
+	}, post.Parameters)
+	assert.Equal(t, []string{"application/json"}, post.Consumes)
+	assert.Nil(t, post.Produces)
+	assert.Equal(t, &Swagger2Response{Description: "Created"}, post.Responses["201"])
+
+	assert.Contains(t, doc.Definitions, "generator.openAPIUser")
+	assert.Equal(t, map[string]*Swagger2SecurityScheme{
+		"BearerAuth": {Type: "apiKey", Name: "Authorization", In: "header", Description: bearerDescription},
+		"BasicAuth":  {Type: "basic"},
+	}, doc.SecurityDefinitions)
+
+	content, err := MarshalSwagger2(routes, groups, nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, json.Valid(content))
+	assert.Contains(t, string(content), `"$ref": "#/definitions/generator.openAPIUser"`)
+}
+
+func TestBuildSwagger2_formParams(t *testing.T) {
+	routes := []Route{
+		{
+			Path:         "/albums",
+			Method:       http.MethodPost,
+			FuncName:     "createAlbum",
+			CookieParams: []Param{{Name: "session", ParamType: "string"}},
+			FormParams: []Param{
+				{Name: "title", ParamType: "string", Required: true},
+				{Name: "photos", ParamType: "file", Array: true, CollectionFormat: "multi"},
+				{Name: "cover", ParamType: "file", MaxSize: 10},
+			},
+		},
+	}
+
+	op := BuildSwagger2(routes, nil, nil, nil).Paths["/albums"]["post"]
+
+	assert.Equal(t, []Swagger2Parameter{
+		{Name: "title", In: "formData", Required: true, Type: "string"},
+		{Name: "photos", In: "formData", Type: "array", Items: &Schema{Type: "file"}, CollectionFormat: "multi"},
+		{Name: "cover", In: "formData", Description: "Maximum size: 10 bytes.", Type: "file"},
+	}, op.Parameters)
+	assert.Equal(t, []string{"multipart/form-data"}, op.Consumes)
+}
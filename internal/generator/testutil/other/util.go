@@ -0,0 +1,6 @@
+// Package testutil declares types named like the ones of the parent testutil package.
+package testutil
+
+type TestGeneric struct {
+	ID int
+}
@@ -0,0 +1,224 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/r0bertson/goswag/models"
+)
+
+// paramTypes are the data types accepted for the parameters, file is only accepted for form parameters.
+var paramTypes = map[string]bool{
+	"string":  true,
+	"int":     true,
+	"integer": true,
+	"number":  true,
+	"bool":    true,
+	"boolean": true,
+	"file":    true,
+}
+
+// validate checks the annotations and body types of all routes, groups and default responses,
+// and that the security schemes they reference are defined. All the problems found are joined in the returned error.
+func validate(routes []Route, groups []Group, defaultResponses []models.ReturnType, opts models.GenerateOptions) error {
+	errs := validateReturns(Route{Path: "default responses"}, defaultResponses)
+	errs = append(errs, validateRoutes(routes, groups))
+
+	return errors.Join(append(errs, validateSecurity(routes, groups, opts))...)
+}
+
+// validateSecurity checks that the security schemes referenced by the routes are defined in the info.
+// The goswag.go files generated without info are completed by the general info written by hand
+// above main, as swag expects, so their schemes are not checked.
+func validateSecurity(routes []Route, groups []Group, opts models.GenerateOptions) error {
+	if opts.Format == models.FormatSwag && opts.Info == nil {
+		return nil
+	}
+
+	schemes := securitySchemes(opts.Info)
+
+	var errs []error
+	for _, r := range routes {
+		for _, name := range r.Security {
+			if name = strings.TrimSpace(name); name == "" {
+				continue
+			}
+
+			if _, ok := schemes[name]; !ok {
+				errs = append(errs, annotationError(r, "undefined security scheme %q", name))
+			}
+		}
+	}
+
+	for _, g := range groups {
+		errs = append(errs, validateSecurity(g.Routes, g.Groups, opts))
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateRoutes(routes []Route, groups []Group) error {
+	var errs []error
+
+	for _, r := range routes {
+		errs = append(errs, validateRoute(r)...)
+	}
+
+	for _, g := range groups {
+		errs = append(errs, validateRoutes(g.Routes, g.Groups))
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateRoute(r Route) []error {
+	var errs []error
+
+	if r.Path == "" {
+		errs = append(errs, annotationError(r, "empty path"))
+	}
+
+	for _, params := range []struct {
+		in   string
+		list []Param
+	}{
+		{"path", r.PathParams}, {"query", r.QueryParams}, {"header", r.HeaderParams},
+		{"cookie", r.CookieParams}, {"form", r.FormParams},
+	} {
+		in := params.in
+		for _, p := range params.list {
+			if p.Name == "" {
+				errs = append(errs, annotationError(r, "%s parameter without name", in))
+			}
+
+			if !paramTypes[p.ParamType] || (p.ParamType == "file" && in != "form") {
+				errs = append(errs, annotationError(r, "unknown type %q for %s parameter %q", p.ParamType, in, p.Name))
+			}
+
+			if _, err := regexp.Compile(p.Pattern); err != nil {
+				errs = append(errs, annotationError(r, "invalid pattern %q for %s parameter %q", p.Pattern, in, p.Name))
+			}
+
+			values := p.Enum
+			for _, value := range []string{p.Default, p.Example} {
+				if value != "" {
+					values = append(values, SplitParamValues(p, value)...)
+				}
+			}
+
+			for _, value := range values {
+				if _, err := paramValue(p.ParamType, value); err != nil {
+					errs = append(errs, annotationError(r, "invalid value %q for %s parameter %q of type %s", value, in, p.Name, p.ParamType))
+				}
+			}
+
+			if p.Minimum != nil && p.Maximum != nil && *p.Minimum > *p.Maximum {
+				errs = append(errs, annotationError(r, "minimum greater than maximum for %s parameter %q", in, p.Name))
+			}
+
+			if p.MinLength != nil && p.MaxLength != nil && *p.MinLength > *p.MaxLength {
+				errs = append(errs, annotationError(r, "minLength greater than maxLength for %s parameter %q", in, p.Name))
+			}
+
+			if _, ok := collectionSeparators[p.CollectionFormat]; !ok || (p.CollectionFormat == "multi" && in != "query" && in != "form") {
+				errs = append(errs, annotationError(r, "invalid collection format %q for %s parameter %q", p.CollectionFormat, in, p.Name))
+			}
+		}
+	}
+
+	if r.Reads != nil && len(r.FormParams) > 0 {
+		errs = append(errs, annotationError(r, "request body and form parameters can not be combined"))
+	}
+
+	if err := typeError(r, r.Reads); err != nil {
+		errs = append(errs, err)
+	}
+
+	return append(errs, validateReturns(r, r.responses())...)
+}
+
+func validateReturns(r Route, returns []models.ReturnType) []error {
+	var errs []error
+
+	for _, data := range returns {
+		if data.StatusCode != 0 && (data.StatusCode < 100 || data.StatusCode > 599) {
+			errs = append(errs, annotationError(r, "invalid status code %d", data.StatusCode))
+		}
+
+		if err := typeError(r, data.Body); err != nil {
+			errs = append(errs, err)
+		}
+
+		for _, object := range data.OverrideStructFields {
+			if err := typeError(r, object); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errs
+}
+
+func annotationError(r Route, format string, args ...interface{}) error {
+	return &models.AnnotationError{Method: r.Method, Path: r.Path, Reason: fmt.Sprintf(format, args...)}
+}
+
+// typeError returns an error if the body has a type that can not be encoded to json.
+func typeError(r Route, body interface{}) error {
+	if body == nil {
+		return nil
+	}
+
+	if t := findUnsupportedType(reflect.TypeOf(body), make(map[reflect.Type]bool)); t != nil {
+		return &models.UnsupportedTypeError{Method: r.Method, Path: r.Path, Type: t}
+	}
+
+	return nil
+}
+
+// findUnsupportedType returns the first type reachable from t that encoding/json can not encode.
+func findUnsupportedType(t reflect.Type, visited map[reflect.Type]bool) reflect.Type {
+	if visited[t] {
+		return nil
+	}
+	visited[t] = true
+
+	if implements(t, jsonMarshalerType) || implements(t, textMarshalerType) {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Complex64, reflect.Complex128, reflect.UnsafePointer:
+		return t
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return findUnsupportedType(t.Elem(), visited)
+	case reflect.Map:
+		switch t.Key().Kind() {
+		case reflect.String,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		default:
+			if !implements(t.Key(), textMarshalerType) {
+				return t
+			}
+		}
+
+		return findUnsupportedType(t.Elem(), visited)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if name, _ := jsonFieldName(field); name == "-" || !field.IsExported() && !field.Anonymous {
+				continue
+			}
+
+			if unsupported := findUnsupportedType(field.Type, visited); unsupported != nil {
+				return unsupported
+			}
+		}
+	}
+
+	return nil
+}
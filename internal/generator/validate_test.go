@@ -0,0 +1,177 @@
+package generator
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/r0bertson/goswag/internal/generator/testutil"
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type testLogger struct {
+	messages []string
+}
+
+func (l *testLogger) Printf(format string, v ...interface{}) {
+	l.messages = append(l.messages, format)
+}
+
+func TestGenerate_validationErrors(t *testing.T) {
+	routes := []Route{
+		{
+			Path:   "/users",
+			Method: http.MethodGet,
+			QueryParams: []Param{
+				{Name: "page", ParamType: "float"},
+				{Name: "limit", ParamType: "int", Default: "ten"},
+				{Name: "ids", ParamType: "int", Array: true, CollectionFormat: "semicolons"},
+			},
+			Returns: []models.ReturnType{{StatusCode: 1000}},
+		},
+	}
+	groups := []Group{
+		{
+			Routes: []Route{
+				{
+					Path:         "/events",
+					Method:       http.MethodPost,
+					Reads:        struct{ C chan int }{},
+					PathParams:   []Param{{Name: "id", ParamType: "string", Pattern: "[0-9"}},
+					FormParams:   []Param{{Name: "file", ParamType: "file"}},
+					CookieParams: []Param{{Name: "avatar", ParamType: "file"}},
+				},
+			},
+		},
+	}
+	logger := &testLogger{}
+
+	err := Generate(routes, groups, nil, models.GenerateOptions{Logger: logger})
+
+	var annotationErr *models.AnnotationError
+	if assert.True(t, errors.As(err, &annotationErr)) {
+		assert.Equal(t, "/users", annotationErr.Path)
+		assert.Equal(t, `unknown type "float" for query parameter "page"`, annotationErr.Reason)
+	}
+
+	var typeErr *models.UnsupportedTypeError
+	if assert.True(t, errors.As(err, &typeErr)) {
+		assert.Equal(t, reflect.TypeOf(make(chan int)), typeErr.Type)
+		assert.Equal(t, "goswag: unsupported type chan int on POST /events", typeErr.Error())
+	}
+
+	assert.Contains(t, err.Error(), "invalid status code 1000")
+	assert.Contains(t, err.Error(), `invalid pattern "[0-9" for path parameter "id"`)
+	assert.Contains(t, err.Error(), `invalid value "ten" for query parameter "limit" of type int`)
+	assert.Contains(t, err.Error(), `invalid collection format "semicolons" for query parameter "ids"`)
+	assert.Contains(t, err.Error(), `unknown type "file" for cookie parameter "avatar"`)
+	assert.Contains(t, err.Error(), "request body and form parameters can not be combined")
+	// nothing is generated when the routes are invalid
+	assert.Empty(t, logger.messages)
+}
+
+func Test_validate(t *testing.T) {
+	tests := []struct {
+		name             string
+		routes           []Route
+		defaultResponses []models.ReturnType
+		opts             models.GenerateOptions
+		wantErr          string
+	}{
+		{
+			name: "Should accept valid routes",
+			routes: []Route{
+				{
+					Path:       "/users/:id",
+					Method:     http.MethodGet,
+					PathParams: []Param{{Name: "id", ParamType: "int", Required: true}},
+					Returns: []models.ReturnType{
+						{StatusCode: http.StatusOK, Body: testutil.StructGeneric[testutil.TestGeneric]{}},
+						{StatusCode: http.StatusNotFound},
+					},
+				},
+			},
+		},
+		{
+			name:    "Should reject parameters without name",
+			routes:  []Route{{Path: "/", Method: http.MethodGet, HeaderParams: []Param{{ParamType: "string"}}}},
+			wantErr: "goswag: invalid annotation on GET /: header parameter without name",
+		},
+		{
+			name:    "Should reject routes without path",
+			routes:  []Route{{Method: http.MethodGet}},
+			wantErr: "goswag: invalid annotation on GET: empty path",
+		},
+		{
+			name: "Should reject unsupported types in override struct fields",
+			routes: []Route{{Path: "/", Method: http.MethodGet, Returns: []models.ReturnType{
+				{StatusCode: http.StatusOK, Body: testutil.OverrideStruct{}, OverrideStructFields: map[string]interface{}{"body": complex(1, 1)}},
+			}}},
+			wantErr: "goswag: unsupported type complex128 on GET /",
+		},
+		{
+			name:             "Should reject invalid default responses",
+			defaultResponses: []models.ReturnType{{StatusCode: 42}},
+			wantErr:          "goswag: invalid annotation on default responses: invalid status code 42",
+		},
+		{
+			name:    "Should reject undefined security schemes",
+			routes:  []Route{{Path: "/", Method: http.MethodGet, Security: []string{"BearerAuth"}}},
+			opts:    models.GenerateOptions{Format: models.FormatOpenAPI},
+			wantErr: `goswag: invalid annotation on GET /: undefined security scheme "BearerAuth"`,
+		},
+		{
+			name:   "Should accept the security schemes defined in the info",
+			routes: []Route{{Path: "/", Method: http.MethodGet, Security: []string{"BearerAuth"}}},
+			opts: models.GenerateOptions{
+				Format: models.FormatSwagger2JSON,
+				Info:   &models.Info{SecuritySchemes: map[string]models.SecurityScheme{"BearerAuth": {Type: models.SecurityBearer}}},
+			},
+		},
+		{
+			name:   "Should not check the security schemes of the swag comments written without info",
+			routes: []Route{{Path: "/", Method: http.MethodGet, Security: []string{"BearerAuth"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate(tt.routes, nil, tt.defaultResponses, tt.opts)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_findUnsupportedType(t *testing.T) {
+	type recursive struct {
+		Next *recursive `json:"next"`
+	}
+	type withFunc struct {
+		Callback func() `json:"-"`
+		private  chan int
+	}
+
+	tests := []struct {
+		name string
+		body interface{}
+		want reflect.Type
+	}{
+		{name: "Should accept recursive types", body: recursive{}},
+		{name: "Should ignore skipped and unexported fields", body: withFunc{}},
+		{name: "Should reject functions", body: []func(){}, want: reflect.TypeOf(func() {})},
+		{name: "Should reject maps with struct keys", body: map[testutil.TestGeneric]int{}, want: reflect.TypeOf(map[testutil.TestGeneric]int{})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, findUnsupportedType(reflect.TypeOf(tt.body), make(map[reflect.Type]bool)))
+		})
+	}
+}
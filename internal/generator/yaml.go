@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// JSONToYAML converts a json document to yaml keeping the order of the object keys.
+func JSONToYAML(content []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.UseNumber()
+
+	node, err := yamlNode(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	enc := yaml.NewEncoder(&b)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(node); err != nil {
+		return nil, err
+	}
+
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func yamlNode(dec *json.Decoder) (*yaml.Node, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := token.(type) {
+	case json.Delim:
+		if v == '{' {
+			node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+
+				value, err := yamlNode(dec)
+				if err != nil {
+					return nil, err
+				}
+
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key.(string)}, value)
+			}
+
+			_, err := dec.Token() // closing '}'
+			return node, err
+		}
+
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for dec.More() {
+			value, err := yamlNode(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			node.Content = append(node.Content, value)
+		}
+
+		_, err := dec.Token() // closing ']'
+		return node, err
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}, nil
+	case json.Number:
+		tag := "!!int"
+		if _, err := v.Int64(); err != nil {
+			tag = "!!float"
+		}
+
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: v.String()}, nil
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: fmt.Sprint(v)}, nil
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	}
+}
@@ -0,0 +1,45 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONToYAML(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Should keep the order of the keys",
+			json: `{"openapi":"3.1.0","info":{"title":"API","version":"1.0"},"components":{}}`,
+			want: "openapi: 3.1.0\ninfo:\n  title: API\n  version: \"1.0\"\ncomponents: {}\n",
+		},
+		{
+			name: "Should convert arrays and scalars",
+			json: `{"required":["id"],"minimum":1,"maximum":1.5,"deprecated":true,"default":null}`,
+			want: "required:\n  - id\nminimum: 1\nmaximum: 1.5\ndeprecated: true\ndefault: null\n",
+		},
+		{
+			name:    "Should return an error for invalid json",
+			json:    `{"openapi"`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := JSONToYAML([]byte(tt.json))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
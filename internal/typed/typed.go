@@ -0,0 +1,227 @@
+// Package typed holds the logic shared by the typed handlers of the frameworks,
+// the handlers whose request and response types are read from their signature.
+package typed
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/models"
+)
+
+// HasBody reports whether the request value is decoded from the body for the method,
+// the other methods bind it from the path and the query when the framework can.
+func HasBody(method string) bool {
+	return method == http.MethodPost || method == http.MethodPut || method == http.MethodPatch
+}
+
+// Status returns the status code of the successful responses of the method.
+func Status(method string) int {
+	if method == http.MethodPost {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+// IsEmpty reports whether the type of v is struct{}, an empty request is not read
+// and an empty response is answered without body.
+func IsEmpty(v any) bool {
+	t := reflect.TypeOf(v)
+	return t != nil && t.Kind() == reflect.Struct && t.NumField() == 0
+}
+
+// ErrorResponse returns the status code and the message answered for the error of a handler.
+// The message of the errors that are not a models.StatusError is not sent to the client.
+func ErrorResponse(err error) (int, string) {
+	var statusErr *models.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Status, statusErr.Error()
+	}
+
+	return http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError)
+}
+
+// FuncName returns the name of the handler function, the routes are named after it instead of the adapter.
+func FuncName(h any) string {
+	fullFuncName := runtime.FuncForPC(reflect.ValueOf(h).Pointer()).Name()
+	funcNameSplit := strings.Split(fullFuncName, ".")
+
+	return strings.TrimSuffix(funcNameSplit[len(funcNameSplit)-1], "-fm")
+}
+
+// Annotate sets the body read by the route from Req.
+// The parameters declared by the tags of the fields of Req are documented with Params.
+// The pointers are documented as the types they point to.
+func Annotate[Req any](route models.Swagger, method string) models.Swagger {
+	var req Req
+	if IsEmpty(req) {
+		return route
+	}
+
+	route.Params(value(reflect.TypeOf(&req).Elem()))
+
+	if HasBody(method) {
+		route.Read(value(reflect.TypeOf(&req).Elem()))
+	}
+
+	return route
+}
+
+// Success returns the successful response of the method with a Resp body, set as the
+// generator.Route Success so the responses declared with Returns are added to it.
+func Success[Resp any](method string) *models.ReturnType {
+	var resp Resp
+
+	returnType := &models.ReturnType{StatusCode: Status(method)}
+	if !IsEmpty(resp) {
+		returnType.Body = value(reflect.TypeOf(&resp).Elem())
+	}
+
+	return returnType
+}
+
+// BindPath sets the fields of v, a pointer to a struct, declared as path parameters by their tags
+// from the values returned by param. The parameters without value leave their fields unchanged.
+func BindPath(v any, param func(name string) string) error {
+	return bindPath(reflect.ValueOf(v), param)
+}
+
+func bindPath(v reflect.Value, param func(name string) string) error {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			if !v.CanSet() {
+				return nil
+			}
+
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		in, name, _, ok := generator.ParamTag(f.Tag)
+		if !ok {
+			// the exported fields of the unexported embedded structs can be set, as encoding/json does
+			if f.Anonymous {
+				if err := bindPath(v.Field(i), param); err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		if in != "path" || !f.IsExported() || name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		value := param(name)
+		if value == "" {
+			continue
+		}
+
+		if err := SetField(v.Field(i), []string{value}); err != nil {
+			return fmt.Errorf("invalid path parameter %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// SetField sets the field from the values of its parameter, slices get every value and the other fields the first one.
+func SetField(field reflect.Value, values []string) error {
+	if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+		slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+		for i, value := range values {
+			if err := setValue(slice.Index(i), value); err != nil {
+				return err
+			}
+		}
+
+		field.Set(slice)
+		return nil
+	}
+
+	return setValue(field, values[0])
+}
+
+func setValue(field reflect.Value, value string) error {
+	if field.Kind() == reflect.Pointer {
+		ptr := reflect.New(field.Type().Elem())
+		if err := setValue(ptr.Elem(), value); err != nil {
+			return err
+		}
+
+		field.Set(ptr)
+		return nil
+	}
+
+	if u, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(value))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+
+		field.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+
+	return nil
+}
+
+func value(t reflect.Type) any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return reflect.New(t).Elem().Interface()
+}
@@ -0,0 +1,112 @@
+package typed
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type user struct {
+	Name string `json:"name"`
+}
+
+// route records the annotations set by Annotate.
+type route struct {
+	models.Swagger
+	params interface{}
+	reads  interface{}
+}
+
+func (r *route) Params(v any) models.Swagger {
+	r.params = v
+	return r
+}
+
+func (r *route) Read(reads interface{}) models.Swagger {
+	r.reads = reads
+	return r
+}
+
+func TestAnnotate(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotate   func(r models.Swagger) models.Swagger
+		wantParams interface{}
+		wantReads  interface{}
+	}{
+		{
+			name:       "should read the request of POST",
+			annotate:   func(r models.Swagger) models.Swagger { return Annotate[user](r, http.MethodPost) },
+			wantParams: user{},
+			wantReads:  user{},
+		},
+		{
+			name:       "should not read the request of GET but declare its params",
+			annotate:   func(r models.Swagger) models.Swagger { return Annotate[*user](r, http.MethodGet) },
+			wantParams: user{},
+		},
+		{
+			name:     "should not document the empty structs",
+			annotate: func(r models.Swagger) models.Swagger { return Annotate[struct{}](r, http.MethodPut) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &route{}
+			tt.annotate(r)
+
+			assert.Equal(t, tt.wantParams, r.params)
+			assert.Equal(t, tt.wantReads, r.reads)
+		})
+	}
+}
+
+func TestSuccess(t *testing.T) {
+	assert.Equal(t, &models.ReturnType{StatusCode: http.StatusCreated, Body: user{}}, Success[*user](http.MethodPost))
+	assert.Equal(t, &models.ReturnType{StatusCode: http.StatusOK, Body: []user(nil)}, Success[[]user](http.MethodGet))
+	assert.Equal(t, &models.ReturnType{StatusCode: http.StatusOK}, Success[struct{}](http.MethodDelete))
+}
+
+func TestBindPath(t *testing.T) {
+	type base struct {
+		Org string `path:"org"`
+	}
+
+	var req struct {
+		base
+		ID    int    `uri:"id"`
+		Slug  string `param:"slug"`
+		Query string `query:"id"`
+		Name  string `json:"name"`
+	}
+
+	params := map[string]string{"org": "acme", "id": "42", "slug": "post"}
+	assert.NoError(t, BindPath(&req, func(name string) string { return params[name] }))
+	assert.Equal(t, "acme", req.Org)
+	assert.Equal(t, 42, req.ID)
+	assert.Equal(t, "post", req.Slug)
+	assert.Empty(t, req.Query)
+
+	params["id"] = "abc"
+	assert.EqualError(t, BindPath(&req, func(name string) string { return params[name] }), `invalid path parameter id: strconv.ParseInt: parsing "abc": invalid syntax`)
+}
+
+func TestErrorResponse(t *testing.T) {
+	status, message := ErrorResponse(&models.StatusError{Status: http.StatusNotFound, Err: errors.New("user not found")})
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Equal(t, "user not found", message)
+
+	status, message = ErrorResponse(errors.New("connection refused"))
+	assert.Equal(t, http.StatusInternalServerError, status)
+	assert.Equal(t, "Internal Server Error", message)
+}
+
+func TestFuncName(t *testing.T) {
+	assert.Equal(t, "TestFuncName", FuncName(TestFuncName))
+	assert.Equal(t, "PathTemplate", FuncName(generator.PathTemplate))
+}
@@ -0,0 +1,56 @@
+package validator
+
+import "strings"
+
+// MatchPath reports whether the request path matches the route path and returns the path parameters.
+// The route path can use the syntax of any supported framework:
+// /users/:id and /files/*path (echo, gin), /users/{id} and /files/{path...} (net/http).
+func MatchPath(routePath, requestPath string) (map[string]string, bool) {
+	routeSegments := strings.Split(strings.Trim(routePath, "/"), "/")
+	requestSegments := strings.Split(strings.Trim(requestPath, "/"), "/")
+	params := make(map[string]string)
+
+	for i, segment := range routeSegments {
+		if name, ok := wildcardName(segment); ok {
+			if i < len(requestSegments) {
+				params[name] = strings.Join(requestSegments[i:], "/")
+			} else {
+				params[name] = ""
+			}
+
+			return params, true
+		}
+
+		if i >= len(requestSegments) {
+			return nil, false
+		}
+
+		switch {
+		case strings.HasPrefix(segment, ":"):
+			params[segment[1:]] = requestSegments[i]
+		case strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}"):
+			params[segment[1:len(segment)-1]] = requestSegments[i]
+		case segment != requestSegments[i]:
+			return nil, false
+		}
+	}
+
+	if len(routeSegments) != len(requestSegments) {
+		return nil, false
+	}
+
+	return params, true
+}
+
+// wildcardName returns the name of a segment that matches the rest of the path.
+func wildcardName(segment string) (string, bool) {
+	if strings.HasPrefix(segment, "*") {
+		return segment[1:], true
+	}
+
+	if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "...}") {
+		return strings.TrimSuffix(segment[1:], "...}"), true
+	}
+
+	return "", false
+}
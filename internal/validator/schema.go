@@ -0,0 +1,109 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/models"
+)
+
+// Resolver returns the schema referenced by a schema, e.g. SchemaBuilder.Resolve or Document.Resolve.
+type Resolver func(s *generator.Schema) *generator.Schema
+
+// ValidateValue checks a value decoded by encoding/json (with UseNumber) against the schema.
+// The references are resolved with resolve, the one of the builder or document that owns the schema.
+// name is the json path of the value, used in the returned errors.
+func ValidateValue(resolve Resolver, schema *generator.Schema, value interface{}, in, name string) []models.ValidationError {
+	schema = resolve(schema)
+	if schema == nil || value == nil {
+		return nil
+	}
+
+	invalid := func(format string, args ...interface{}) []models.ValidationError {
+		return []models.ValidationError{{In: in, Name: name, Reason: fmt.Sprintf(format, args...)}}
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return invalid("must be an object")
+		}
+
+		return validateObject(resolve, schema, object, in, name)
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			return invalid("must be an array")
+		}
+
+		var errs []models.ValidationError
+		for i, item := range array {
+			errs = append(errs, ValidateValue(resolve, schema.Items, item, in, fmt.Sprintf("%s[%d]", name, i))...)
+		}
+
+		return errs
+	case "string":
+		if _, ok := value.(string); !ok {
+			return invalid("must be a string")
+		}
+	case "integer":
+		if n, ok := value.(json.Number); !ok {
+			return invalid("must be an integer")
+		} else if _, err := n.Int64(); err != nil {
+			return invalid("must be an integer")
+		}
+	case "number":
+		if _, ok := value.(json.Number); !ok {
+			return invalid("must be a number")
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return invalid("must be a boolean")
+		}
+	}
+
+	return nil
+}
+
+func validateObject(resolve Resolver, schema *generator.Schema, object map[string]interface{}, in, name string) []models.ValidationError {
+	var errs []models.ValidationError
+
+	// like encoding/json, null is accepted for any field: a nil slice is
+	// encoded as null and decoding null leaves the zero value
+	for _, field := range schema.Required {
+		if _, ok := object[field]; !ok {
+			errs = append(errs, models.ValidationError{In: in, Name: join(name, field), Reason: "is required"})
+		}
+	}
+
+	// the fields are sorted to return the errors always in the same order
+	fields := make([]string, 0, len(object))
+	for field := range object {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		property, ok := schema.Properties[field]
+		if !ok {
+			property = schema.AdditionalProperties
+		}
+
+		if property != nil {
+			errs = append(errs, ValidateValue(resolve, property, object[field], in, join(name, field))...)
+		}
+	}
+
+	return errs
+}
+
+func join(name, field string) string {
+	if name == "" {
+		return field
+	}
+
+	return name + "." + field
+}
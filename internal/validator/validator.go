@@ -0,0 +1,382 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/models"
+)
+
+// Validator checks requests against the documentation of a route:
+// required and typed parameters and the json body defined by Read.
+// The required fields of the body must be present, but unlike gin and go-playground/validator
+// their zero values, e.g. "" or 0, are accepted and left to the validator of the framework.
+type Validator struct {
+	route   generator.Route
+	schemas *generator.SchemaBuilder
+	body    *generator.Schema
+	// maxBodySize is the maximum size of the json bodies, models.DefaultMaxBodySize when it is not set.
+	maxBodySize int64
+}
+
+// New returns the validator of the route.
+func New(route generator.Route) *Validator {
+	schemas := generator.NewRequestSchemaBuilder("#/definitions/")
+
+	return &Validator{
+		route:   route,
+		schemas: schemas,
+		body:    schemas.RequestSchema(route.Reads),
+	}
+}
+
+// Validate returns the problems found in the request, pathParam returns the value of a path parameter.
+// The request body is read and replaced, so the handlers can still read it. The body of a route with
+// form parameters is parsed instead, the handlers read it with Request.FormValue and Request.FormFile.
+func (v *Validator) Validate(r *http.Request, pathParam func(name string) string) []models.ValidationError {
+	var errs []models.ValidationError
+
+	for _, p := range v.route.PathParams {
+		value := pathParam(p.Name)
+		errs = append(errs, validateParam("path", p, value, value != "")...)
+	}
+
+	query := r.URL.Query()
+	for _, p := range v.route.QueryParams {
+		value := query.Get(p.Name)
+		if p.Array && p.CollectionFormat == "multi" {
+			// the values are repeated parameters
+			value = strings.Join(query[p.Name], ",")
+		}
+
+		errs = append(errs, validateParam("query", p, value, query.Has(p.Name))...)
+	}
+
+	for _, p := range v.route.HeaderParams {
+		_, present := r.Header[http.CanonicalHeaderKey(p.Name)]
+		errs = append(errs, validateParam("header", p, r.Header.Get(p.Name), present)...)
+	}
+
+	for _, p := range v.route.CookieParams {
+		var value string
+		cookie, err := r.Cookie(p.Name)
+		if err == nil {
+			value = cookie.Value
+		}
+
+		errs = append(errs, validateParam("cookie", p, value, err == nil)...)
+	}
+
+	if len(v.route.FormParams) > 0 {
+		errs = append(errs, v.validateForm(r)...)
+	} else if v.body != nil {
+		errs = append(errs, v.validateBody(r)...)
+	}
+
+	return errs
+}
+
+// maxFormMemory is the memory used to parse multipart forms, the one of Request.FormFile.
+const maxFormMemory = 32 << 20
+
+func (v *Validator) validateForm(r *http.Request) []models.ValidationError {
+	var err error
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "multipart/form-data" {
+		err = r.ParseMultipartForm(maxFormMemory)
+	} else {
+		err = r.ParseForm()
+	}
+
+	if err != nil {
+		return []models.ValidationError{{In: "body", Reason: "must be a valid form"}}
+	}
+
+	var files map[string][]*multipart.FileHeader
+	if r.MultipartForm != nil {
+		files = r.MultipartForm.File
+	}
+
+	var errs []models.ValidationError
+	for _, p := range v.route.FormParams {
+		if p.ParamType == "file" {
+			errs = append(errs, validateFiles(p, files[p.Name])...)
+			continue
+		}
+
+		values := r.PostForm[p.Name]
+		value := r.PostForm.Get(p.Name)
+		if p.Array && p.CollectionFormat == "multi" {
+			// the values are repeated fields
+			value = strings.Join(values, ",")
+		}
+
+		errs = append(errs, validateParam("form", p, value, len(values) > 0)...)
+	}
+
+	return errs
+}
+
+func validateFiles(p generator.Param, files []*multipart.FileHeader) []models.ValidationError {
+	invalid := func(reason string) []models.ValidationError {
+		return []models.ValidationError{{In: "form", Name: p.Name, Reason: reason}}
+	}
+
+	if len(files) == 0 {
+		if p.Required {
+			return invalid("is required")
+		}
+
+		return nil
+	}
+
+	if !p.Array && len(files) > 1 {
+		return invalid("must be a single file")
+	}
+
+	for _, f := range files {
+		if p.MaxSize > 0 && f.Size > p.MaxSize {
+			return invalid(fmt.Sprintf("must be at most %d bytes", p.MaxSize))
+		}
+
+		if len(p.MimeTypes) > 0 && !matchMimeType(p.MimeTypes, f.Header.Get("Content-Type")) {
+			return invalid("must be of type " + strings.Join(p.MimeTypes, ", "))
+		}
+	}
+
+	return nil
+}
+
+// matchMimeType reports if the content type is one of the allowed mime types, image/* matches all the images.
+func matchMimeType(allowed []string, contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	for _, a := range allowed {
+		if a == mediaType || a == "*/*" || (strings.HasSuffix(a, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(a, "*"))) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v *Validator) validateBody(r *http.Request) []models.ValidationError {
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, _ := mime.ParseMediaType(contentType)
+		if mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json") {
+			// only json bodies are validated
+			return nil
+		}
+	}
+
+	limit := v.maxBodySize
+	if limit <= 0 {
+		limit = models.DefaultMaxBodySize
+	}
+
+	var content []byte
+	if r.Body != nil {
+		var err error
+		content, err = io.ReadAll(http.MaxBytesReader(nil, r.Body, limit))
+
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return []models.ValidationError{{In: "body", Reason: fmt.Sprintf("must be at most %d bytes", limit)}}
+		}
+
+		if err != nil {
+			return []models.ValidationError{{In: "body", Reason: "could not be read"}}
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(content))
+	}
+
+	if len(bytes.TrimSpace(content)) == 0 {
+		return []models.ValidationError{{In: "body", Reason: "is required"}}
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.UseNumber()
+
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return []models.ValidationError{{In: "body", Reason: "must be valid json"}}
+	}
+
+	return ValidateValue(v.schemas.Resolve, v.body, value, "body", "")
+}
+
+func validateParam(in string, p generator.Param, value string, present bool) []models.ValidationError {
+	if !present {
+		if p.Required {
+			return []models.ValidationError{{In: in, Name: p.Name, Reason: "is required"}}
+		}
+
+		return nil
+	}
+
+	for _, v := range generator.SplitParamValues(p, value) {
+		if reason := invalidParamValue(p, v); reason != "" {
+			return []models.ValidationError{{In: in, Name: p.Name, Reason: reason}}
+		}
+	}
+
+	return nil
+}
+
+// invalidParamValue returns why the value does not match the parameter, or an empty string.
+func invalidParamValue(p generator.Param, value string) string {
+	var (
+		n   float64
+		err error
+	)
+
+	switch p.ParamType {
+	case "int", "integer":
+		var i int64
+		i, err = strconv.ParseInt(value, 10, 64)
+		n = float64(i)
+	case "number":
+		n, err = strconv.ParseFloat(value, 64)
+	case "bool", "boolean":
+		_, err = strconv.ParseBool(value)
+	}
+
+	if err != nil {
+		return "must be of type " + p.ParamType
+	}
+
+	if p.Pattern != "" {
+		if matched, err := regexp.MatchString(p.Pattern, value); err == nil && !matched {
+			return "must match the pattern " + p.Pattern
+		}
+	}
+
+	if len(p.Enum) > 0 && !slices.Contains(p.Enum, value) {
+		return "must be one of " + strings.Join(p.Enum, ", ")
+	}
+
+	if p.Minimum != nil && n < *p.Minimum {
+		return fmt.Sprintf("must be at least %v", *p.Minimum)
+	}
+
+	if p.Maximum != nil && n > *p.Maximum {
+		return fmt.Sprintf("must be at most %v", *p.Maximum)
+	}
+
+	if length := utf8.RuneCountInString(value); p.MinLength != nil && length < *p.MinLength {
+		return fmt.Sprintf("must have at least %d characters", *p.MinLength)
+	} else if p.MaxLength != nil && length > *p.MaxLength {
+		return fmt.Sprintf("must have at most %d characters", *p.MaxLength)
+	}
+
+	return ""
+}
+
+// Registry finds the validator of the route that handles a request.
+// The validators are created on the first request of each documented route.
+type Registry struct {
+	routes func() ([]generator.Route, []generator.Group)
+	opts   models.ValidationOptions
+	cache  sync.Map
+}
+
+// NewRegistry returns a registry for the routes returned by the function.
+// The function is called lazily, so routes registered after the registry creation are found.
+func NewRegistry(routes func() ([]generator.Route, []generator.Group), opts ...models.ValidationOptions) *Registry {
+	r := &Registry{routes: routes}
+	if len(opts) > 0 {
+		r.opts = opts[0]
+	}
+
+	if r.opts.Status == 0 {
+		r.opts.Status = http.StatusBadRequest
+	}
+
+	return r
+}
+
+// Check validates the request handled by the route registered with the method of the request and the path.
+// When the request is invalid, the problem response is written and false is returned.
+// Requests of undocumented routes are always valid.
+func (r *Registry) Check(w http.ResponseWriter, req *http.Request, path string, pathParam func(name string) string) bool {
+	v := r.lookup(req.Method, path)
+	if v == nil {
+		return true
+	}
+
+	errs := v.Validate(req, pathParam)
+	if len(errs) == 0 {
+		return true
+	}
+
+	problem := models.ValidationProblem{
+		Type:   "about:blank",
+		Title:  http.StatusText(r.opts.Status),
+		Status: r.opts.Status,
+		Detail: "the request does not match the documentation of the route",
+		Errors: errs,
+	}
+
+	if r.opts.ProblemHandler != nil {
+		r.opts.ProblemHandler(w, req, problem)
+		return false
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(problem.Status)
+	_ = json.NewEncoder(w).Encode(problem)
+
+	return false
+}
+
+func (r *Registry) lookup(method, path string) *Validator {
+	key := method + " " + generator.OpenAPIPath(path)
+	if v, ok := r.cache.Load(key); ok {
+		return v.(*Validator)
+	}
+
+	// the misses are not cached, the route may be registered after its first request
+	routes, groups := r.routes()
+	route, ok := findRoute(method, generator.OpenAPIPath(path), routes, groups)
+	if !ok {
+		return nil
+	}
+
+	v := New(route)
+	v.maxBodySize = r.opts.MaxBodySize
+	r.cache.Store(key, v)
+
+	return v
+}
+
+func findRoute(method, path string, routes []generator.Route, groups []generator.Group) (generator.Route, bool) {
+	for _, route := range routes {
+		if route.Method == method && generator.OpenAPIPath(route.Path) == path {
+			return route, true
+		}
+	}
+
+	for _, g := range groups {
+		if route, ok := findRoute(method, path, g.Routes, g.Groups); ok {
+			return route, true
+		}
+	}
+
+	return generator.Route{}, false
+}
@@ -0,0 +1,432 @@
+package validator
+
+import (
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/r0bertson/goswag/internal/generator"
+	"github.com/r0bertson/goswag/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	City string `json:"city"`
+}
+
+type createUser struct {
+	Name     string            `json:"name"`
+	Age      int               `json:"age,omitempty"`
+	Admin    bool              `json:"admin,omitempty"`
+	Address  address           `json:"address"`
+	Tags     []string          `json:"tags,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+func TestValidator_Validate(t *testing.T) {
+	ten, two := 10.0, 2
+	route := generator.Route{
+		Method:     http.MethodPost,
+		Path:       "/users/:id",
+		Reads:      createUser{},
+		PathParams: []generator.Param{{Name: "id", ParamType: "int", Required: true, Pattern: "^[1-9][0-9]*$"}},
+		QueryParams: []generator.Param{
+			{Name: "limit", ParamType: "integer"},
+			{Name: "price", ParamType: "number"},
+			{Name: "active", ParamType: "bool", Required: true},
+			{Name: "sort", ParamType: "string", Enum: []string{"asc", "desc"}},
+			{Name: "ids", ParamType: "int", Array: true, CollectionFormat: "multi", Maximum: &ten},
+			{Name: "codes", ParamType: "string", Array: true, CollectionFormat: "pipes", MinLength: &two},
+		},
+		HeaderParams: []generator.Param{{Name: "X-Request-ID", ParamType: "string", Required: true}},
+	}
+
+	tests := []struct {
+		name        string
+		id          string
+		query       string
+		header      bool
+		contentType string
+		body        string
+		want        []models.ValidationError
+	}{
+		{
+			name:   "Should accept a valid request",
+			id:     "1",
+			query:  "limit=10&price=1.5&active=true",
+			header: true,
+			body:   `{"name":"john","age":30,"address":{"city":"Lisbon"},"tags":["a"],"metadata":{"k":"v"}}`,
+		},
+		{
+			name:  "Should report the missing required params and body",
+			query: "limit=10",
+			want: []models.ValidationError{
+				{In: "path", Name: "id", Reason: "is required"},
+				{In: "query", Name: "active", Reason: "is required"},
+				{In: "header", Name: "X-Request-ID", Reason: "is required"},
+				{In: "body", Reason: "is required"},
+			},
+		},
+		{
+			name:   "Should report the params with the wrong type",
+			id:     "abc",
+			query:  "limit=1.5&price=abc&active=yes",
+			header: true,
+			body:   `{"name":"john","address":{"city":"Lisbon"}}`,
+			want: []models.ValidationError{
+				{In: "path", Name: "id", Reason: "must be of type int"},
+				{In: "query", Name: "limit", Reason: "must be of type integer"},
+				{In: "query", Name: "price", Reason: "must be of type number"},
+				{In: "query", Name: "active", Reason: "must be of type bool"},
+			},
+		},
+		{
+			name:   "Should report the params that do not match their pattern",
+			id:     "007",
+			query:  "active=true",
+			header: true,
+			body:   `{"name":"john","address":{"city":"Lisbon"}}`,
+			want:   []models.ValidationError{{In: "path", Name: "id", Reason: "must match the pattern ^[1-9][0-9]*$"}},
+		},
+		{
+			name:   "Should report the params that are not one of their enum values",
+			id:     "1",
+			query:  "active=true&sort=up",
+			header: true,
+			body:   `{"name":"john","address":{"city":"Lisbon"}}`,
+			want:   []models.ValidationError{{In: "query", Name: "sort", Reason: "must be one of asc, desc"}},
+		},
+		{
+			name:   "Should validate each value of the array params",
+			id:     "1",
+			query:  "active=true&ids=1&ids=20&codes=ab|c",
+			header: true,
+			body:   `{"name":"john","address":{"city":"Lisbon"}}`,
+			want: []models.ValidationError{
+				{In: "query", Name: "ids", Reason: "must be at most 10"},
+				{In: "query", Name: "codes", Reason: "must have at least 2 characters"},
+			},
+		},
+		{
+			name:   "Should accept the array params within their bounds",
+			id:     "1",
+			query:  "active=true&ids=1&ids=10&codes=ab|cd",
+			header: true,
+			body:   `{"name":"john","address":{"city":"Lisbon"}}`,
+		},
+		{
+			name:   "Should report the invalid body fields",
+			id:     "1",
+			query:  "active=false",
+			header: true,
+			body:   `{"age":"30","admin":1,"address":{"city":null},"tags":[1],"metadata":{"k":2}}`,
+			want: []models.ValidationError{
+				{In: "body", Name: "admin", Reason: "must be a boolean"},
+				{In: "body", Name: "age", Reason: "must be an integer"},
+				{In: "body", Name: "metadata.k", Reason: "must be a string"},
+				{In: "body", Name: "tags[0]", Reason: "must be a string"},
+			},
+		},
+		{
+			name:   "Should report an invalid json body",
+			id:     "1",
+			query:  "active=1",
+			header: true,
+			body:   `{"name":`,
+			want:   []models.ValidationError{{In: "body", Reason: "must be valid json"}},
+		},
+		{
+			name:        "Should not validate bodies that are not json",
+			id:          "1",
+			query:       "active=1",
+			header:      true,
+			contentType: "application/xml",
+			body:        `<user/>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/users/"+tt.id+"?"+tt.query, strings.NewReader(tt.body))
+			if tt.header {
+				r.Header.Set("X-Request-ID", "1")
+			}
+
+			if tt.contentType != "" {
+				r.Header.Set("Content-Type", tt.contentType)
+			}
+
+			got := New(route).Validate(r, func(name string) string {
+				if name == "id" {
+					return tt.id
+				}
+
+				return ""
+			})
+			assert.Equal(t, tt.want, got)
+
+			// the body can still be read by the handler
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.body, string(body))
+		})
+	}
+}
+
+func TestValidator_ValidateForm(t *testing.T) {
+	route := generator.Route{
+		Method:       http.MethodPost,
+		Path:         "/albums",
+		CookieParams: []generator.Param{{Name: "session", ParamType: "string", Required: true}},
+		FormParams: []generator.Param{
+			{Name: "title", ParamType: "string", Required: true},
+			{Name: "year", ParamType: "int"},
+			{Name: "cover", ParamType: "file", Required: true, MimeTypes: []string{"image/*"}, MaxSize: 10},
+			{Name: "photos", ParamType: "file", Array: true, CollectionFormat: "multi"},
+		},
+	}
+
+	type file struct{ field, contentType, content string }
+
+	tests := []struct {
+		name   string
+		fields map[string]string
+		files  []file
+		cookie bool
+		want   []models.ValidationError
+	}{
+		{
+			name:   "Should accept a valid form",
+			fields: map[string]string{"title": "holidays", "year": "2024"},
+			files:  []file{{"cover", "image/png", "png"}, {"photos", "image/jpeg", "a"}, {"photos", "image/jpeg", "b"}},
+			cookie: true,
+		},
+		{
+			name: "Should report the missing required cookie, fields and files",
+			want: []models.ValidationError{
+				{In: "cookie", Name: "session", Reason: "is required"},
+				{In: "form", Name: "title", Reason: "is required"},
+				{In: "form", Name: "cover", Reason: "is required"},
+			},
+		},
+		{
+			name:   "Should report the invalid fields and files",
+			fields: map[string]string{"title": "holidays", "year": "last"},
+			files:  []file{{"cover", "text/plain", "png"}},
+			cookie: true,
+			want: []models.ValidationError{
+				{In: "form", Name: "year", Reason: "must be of type int"},
+				{In: "form", Name: "cover", Reason: "must be of type image/*"},
+			},
+		},
+		{
+			name:   "Should report several files sent for a single file",
+			fields: map[string]string{"title": "holidays"},
+			files:  []file{{"cover", "image/png", "a large cover"}, {"cover", "image/png", "png"}},
+			cookie: true,
+			want:   []models.ValidationError{{In: "form", Name: "cover", Reason: "must be a single file"}},
+		},
+		{
+			name:   "Should report the files larger than their maximum size",
+			fields: map[string]string{"title": "holidays"},
+			files:  []file{{"cover", "image/png", "a large cover"}},
+			cookie: true,
+			want:   []models.ValidationError{{In: "form", Name: "cover", Reason: "must be at most 10 bytes"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body strings.Builder
+			w := multipart.NewWriter(&body)
+			for name, value := range tt.fields {
+				assert.NoError(t, w.WriteField(name, value))
+			}
+
+			for _, f := range tt.files {
+				header := make(textproto.MIMEHeader)
+				header.Set("Content-Disposition", `form-data; name="`+f.field+`"; filename="file"`)
+				header.Set("Content-Type", f.contentType)
+
+				part, err := w.CreatePart(header)
+				assert.NoError(t, err)
+				_, _ = io.WriteString(part, f.content)
+			}
+			assert.NoError(t, w.Close())
+
+			r := httptest.NewRequest(http.MethodPost, "/albums", strings.NewReader(body.String()))
+			r.Header.Set("Content-Type", w.FormDataContentType())
+			if tt.cookie {
+				r.AddCookie(&http.Cookie{Name: "session", Value: "abc"})
+			}
+
+			assert.Equal(t, tt.want, New(route).Validate(r, func(string) string { return "" }))
+		})
+	}
+}
+
+type signup struct {
+	Email string `json:"email,omitempty" binding:"required,email"`
+	Name  string `json:"name,omitempty" validate:"required,min=2,max=5"`
+	Plan  string `json:"plan,omitempty" binding:"oneof=free pro"`
+}
+
+func TestValidator_ValidateBodyRequiredTags(t *testing.T) {
+	route := generator.Route{Method: http.MethodPost, Path: "/signup", Reads: signup{}}
+
+	tests := []struct {
+		name string
+		body string
+		want []models.ValidationError
+	}{
+		{
+			name: "Should accept a body with the fields required by the tags",
+			body: `{"email":"a@b.c","name":"j"}`,
+		},
+		{
+			name: "Should report the missing fields required by the tags",
+			body: `{"plan":"gold"}`,
+			want: []models.ValidationError{
+				{In: "body", Name: "email", Reason: "is required"},
+				{In: "body", Name: "name", Reason: "is required"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", "application/json")
+
+			assert.Equal(t, tt.want, New(route).Validate(r, func(string) string { return "" }))
+		})
+	}
+}
+
+func TestValidator_ValidatePartialBody(t *testing.T) {
+	type person struct {
+		Name    string  `json:"name"`
+		Age     int     `json:"age"`
+		Address address `json:"address"`
+	}
+
+	route := generator.Route{Method: http.MethodPost, Path: "/people", Reads: person{}}
+
+	// encoding/json and the frameworks accept missing fields, only the required tags require them
+	r := httptest.NewRequest(http.MethodPost, "/people", strings.NewReader(`{"name":"x","address":{}}`))
+	r.Header.Set("Content-Type", "application/json")
+
+	assert.Empty(t, New(route).Validate(r, func(string) string { return "" }))
+}
+
+func TestRegistry_Check(t *testing.T) {
+	routes := []generator.Route{{Method: http.MethodGet, Path: "/users", QueryParams: []generator.Param{{Name: "limit", ParamType: "int", Required: true}}}}
+	groups := []generator.Group{{GroupName: "/items", Routes: []generator.Route{{Method: http.MethodGet, Path: "/items/:id", PathParams: []generator.Param{{Name: "id", ParamType: "int", Required: true}}}}}}
+	source := func() ([]generator.Route, []generator.Group) { return routes, groups }
+	noParams := func(string) string { return "" }
+
+	t.Run("Should accept undocumented routes", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		assert.True(t, NewRegistry(source).Check(w, httptest.NewRequest(http.MethodPost, "/users", nil), "/users", noParams))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Should find the routes of the groups whatever the path syntax", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ok := NewRegistry(source).Check(w, httptest.NewRequest(http.MethodGet, "/items/1", nil), "/items/{id}", func(string) string { return "1" })
+		assert.True(t, ok)
+	})
+
+	t.Run("Should write the problem of an invalid request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		assert.False(t, NewRegistry(source).Check(w, httptest.NewRequest(http.MethodGet, "/users", nil), "/users", noParams))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+
+		var problem models.ValidationProblem
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+		assert.Equal(t, models.ValidationProblem{
+			Type:   "about:blank",
+			Title:  "Bad Request",
+			Status: http.StatusBadRequest,
+			Detail: "the request does not match the documentation of the route",
+			Errors: []models.ValidationError{{In: "query", Name: "limit", Reason: "is required"}},
+		}, problem)
+	})
+
+	t.Run("Should use the configured status and problem handler", func(t *testing.T) {
+		var got models.ValidationProblem
+		registry := NewRegistry(source, models.ValidationOptions{
+			Status: http.StatusUnprocessableEntity,
+			ProblemHandler: func(w http.ResponseWriter, r *http.Request, problem models.ValidationProblem) {
+				got = problem
+				w.WriteHeader(problem.Status)
+			},
+		})
+
+		w := httptest.NewRecorder()
+		assert.False(t, registry.Check(w, httptest.NewRequest(http.MethodGet, "/users?limit=a", nil), "/users", noParams))
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, "Unprocessable Entity", got.Title)
+		assert.Equal(t, []models.ValidationError{{In: "query", Name: "limit", Reason: "must be of type int"}}, got.Errors)
+	})
+
+	t.Run("Should reject the bodies larger than the maximum size", func(t *testing.T) {
+		routes := []generator.Route{{Method: http.MethodPost, Path: "/users", Reads: createUser{}}}
+		source := func() ([]generator.Route, []generator.Group) { return routes, nil }
+		registry := NewRegistry(source, models.ValidationOptions{MaxBodySize: 48})
+
+		var got models.ValidationProblem
+		w := httptest.NewRecorder()
+		body := `{"name": "john", "address": {"city": "` + strings.Repeat("a", 64) + `"}}`
+		assert.False(t, registry.Check(w, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body)), "/users", noParams))
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, []models.ValidationError{{In: "body", Reason: "must be at most 48 bytes"}}, got.Errors)
+
+		w = httptest.NewRecorder()
+		assert.True(t, registry.Check(w, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"name":"john","address":{"city":"x"}}`)), "/users", noParams))
+	})
+
+	t.Run("Should validate the routes registered after their first request", func(t *testing.T) {
+		var routes []generator.Route
+		registry := NewRegistry(func() ([]generator.Route, []generator.Group) { return routes, nil })
+		req := func() *http.Request { return httptest.NewRequest(http.MethodGet, "/users", nil) }
+
+		assert.True(t, registry.Check(httptest.NewRecorder(), req(), "/users", noParams))
+
+		routes = []generator.Route{{Method: http.MethodGet, Path: "/users", QueryParams: []generator.Param{{Name: "limit", ParamType: "int", Required: true}}}}
+		assert.False(t, registry.Check(httptest.NewRecorder(), req(), "/users", noParams))
+	})
+}
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		name        string
+		routePath   string
+		requestPath string
+		want        map[string]string
+		wantOK      bool
+	}{
+		{name: "Should match a static path", routePath: "/users", requestPath: "/users/", want: map[string]string{}, wantOK: true},
+		{name: "Should match colon params", routePath: "/users/:id/items/:item", requestPath: "/users/1/items/2", want: map[string]string{"id": "1", "item": "2"}, wantOK: true},
+		{name: "Should match brace params", routePath: "/users/{id}", requestPath: "/users/1", want: map[string]string{"id": "1"}, wantOK: true},
+		{name: "Should match a star wildcard", routePath: "/files/*path", requestPath: "/files/a/b.txt", want: map[string]string{"path": "a/b.txt"}, wantOK: true},
+		{name: "Should match a net/http wildcard", routePath: "/files/{path...}", requestPath: "/files/a/b.txt", want: map[string]string{"path": "a/b.txt"}, wantOK: true},
+		{name: "Should not match another static segment", routePath: "/users/:id", requestPath: "/items/1"},
+		{name: "Should not match a longer path", routePath: "/users/:id", requestPath: "/users/1/items"},
+		{name: "Should not match a shorter path", routePath: "/users/:id", requestPath: "/users"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := MatchPath(tt.routePath, tt.requestPath)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package models
+
+import "net/http"
+
+type ChiRouter interface {
+	// Use appends one or more middlewares onto the middleware stack of the router.
+	Use(middlewares ...func(http.Handler) http.Handler)
+
+	// With adds inline middlewares for the routes registered on the returned router.
+	With(middlewares ...func(http.Handler) http.Handler) ChiRouter
+
+	// Group creates an inline router with a fresh middleware stack, the routes keep the prefix and tags of the router.
+	Group(fn func(r ChiRouter)) ChiRouter
+
+	// Route mounts a sub-router along the pattern, the pattern is the prefix of its routes
+	// and automatically creates tags for the swagger documentation.
+	Route(pattern string, fn func(r ChiRouter)) ChiRouter
+
+	// Mount attaches another http.Handler along the pattern. When the handler is a router
+	// created by goswag.NewChi, its routes are documented with the pattern as prefix and tag.
+	Mount(pattern string, h http.Handler)
+
+	// Method registers a handler for the http method and pattern.
+	Method(method, pattern string, h http.Handler) Swagger
+
+	// MethodFunc registers a handler function for the http method and pattern.
+	MethodFunc(method, pattern string, h http.HandlerFunc) Swagger
+
+	// Any registers a handler for every http method on the pattern, it is documented
+	// for the methods GET, POST, PUT, PATCH, DELETE, HEAD and OPTIONS.
+	Any(pattern string, h http.HandlerFunc) MultiSwagger
+
+	// Match registers a handler for the http methods on the pattern, it is documented once per method.
+	Match(methods []string, pattern string, h http.HandlerFunc) MultiSwagger
+
+	// Get is a shortcut for router.MethodFunc("GET", pattern, h).
+	Get(pattern string, h http.HandlerFunc) Swagger
+
+	// Post is a shortcut for router.MethodFunc("POST", pattern, h).
+	Post(pattern string, h http.HandlerFunc) Swagger
+
+	// Put is a shortcut for router.MethodFunc("PUT", pattern, h).
+	Put(pattern string, h http.HandlerFunc) Swagger
+
+	// Delete is a shortcut for router.MethodFunc("DELETE", pattern, h).
+	Delete(pattern string, h http.HandlerFunc) Swagger
+
+	// Patch is a shortcut for router.MethodFunc("PATCH", pattern, h).
+	Patch(pattern string, h http.HandlerFunc) Swagger
+
+	// Options is a shortcut for router.MethodFunc("OPTIONS", pattern, h).
+	Options(pattern string, h http.HandlerFunc) Swagger
+
+	// Head is a shortcut for router.MethodFunc("HEAD", pattern, h).
+	Head(pattern string, h http.HandlerFunc) Swagger
+}
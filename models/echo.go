@@ -32,6 +32,15 @@ type EchoRouter interface {
 	// HEAD registers a new HEAD route for a path with matching handler in the router
 	// with optional route-level middleware.
 	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Swagger
+
+	// Any registers a new route for all http methods and path with matching handler in the router
+	// with optional route-level middleware. It is documented for the methods GET, POST, PUT,
+	// PATCH, DELETE, HEAD and OPTIONS.
+	Any(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) MultiSwagger
+
+	// Match registers a new route for multiple http methods and path with matching handler in the
+	// router with optional route-level middleware. It is documented once per method.
+	Match(methods []string, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) MultiSwagger
 }
 
 type EchoGroup interface {
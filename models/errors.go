@@ -0,0 +1,71 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// OutputError is returned when the generated file can not be formatted or written.
+type OutputError struct {
+	Path string
+	Err  error
+}
+
+func (e *OutputError) Error() string {
+	return fmt.Sprintf("goswag: writing %s: %v", e.Path, e.Err)
+}
+
+func (e *OutputError) Unwrap() error {
+	return e.Err
+}
+
+// AnnotationError is returned when a route has an invalid annotation,
+// e.g. a parameter without name or with an unknown data type.
+type AnnotationError struct {
+	Method string
+	Path   string
+	Reason string
+}
+
+func (e *AnnotationError) Error() string {
+	return fmt.Sprintf("goswag: invalid annotation on %s: %s", strings.TrimSpace(e.Method+" "+e.Path), e.Reason)
+}
+
+// OptionsError is returned when an option of the generation is invalid,
+// e.g. a package name that is not an identifier.
+type OptionsError struct {
+	Option string
+	Reason string
+}
+
+func (e *OptionsError) Error() string {
+	return fmt.Sprintf("goswag: invalid option %s: %s", e.Option, e.Reason)
+}
+
+// UnsupportedTypeError is returned when a body can not be represented in the documentation,
+// e.g. channels, functions and complex numbers, that can not be encoded to json.
+type UnsupportedTypeError struct {
+	Method string
+	Path   string
+	Type   reflect.Type
+}
+
+func (e *UnsupportedTypeError) Error() string {
+	return fmt.Sprintf("goswag: unsupported type %s on %s", e.Type, strings.TrimSpace(e.Method+" "+e.Path))
+}
+
+// StatusError is returned by the typed handlers to answer with its status code,
+// the message of Err is sent to the client. The other errors are answered with 500.
+type StatusError struct {
+	Status int
+	Err    error
+}
+
+func (e *StatusError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
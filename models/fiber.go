@@ -0,0 +1,43 @@
+package models
+
+import "github.com/gofiber/fiber/v2"
+
+type FiberRouter interface {
+	// Use registers a middleware route that will match requests with the provided prefix,
+	// as fiber.App.Use: Use(handler), Use(prefix, handler) or Use(prefix, handler, handler...).
+	Use(args ...interface{}) FiberRouter
+
+	// Get registers a route for GET methods that requests a representation of the specified resource.
+	// The last handler should be the real handler, the other ones are middlewares.
+	Get(path string, handlers ...fiber.Handler) Swagger
+
+	// Post registers a route for POST methods.
+	Post(path string, handlers ...fiber.Handler) Swagger
+
+	// Put registers a route for PUT methods.
+	Put(path string, handlers ...fiber.Handler) Swagger
+
+	// Delete registers a route for DELETE methods.
+	Delete(path string, handlers ...fiber.Handler) Swagger
+
+	// Patch registers a route for PATCH methods.
+	Patch(path string, handlers ...fiber.Handler) Swagger
+
+	// Options registers a route for OPTIONS methods.
+	Options(path string, handlers ...fiber.Handler) Swagger
+
+	// Head registers a route for HEAD methods.
+	Head(path string, handlers ...fiber.Handler) Swagger
+
+	// Any registers a route for all http methods, it is documented for the methods
+	// GET, POST, PUT, PATCH, DELETE, HEAD and OPTIONS.
+	Any(path string, handlers ...fiber.Handler) MultiSwagger
+
+	// Match registers a route for the http methods, it is documented once per method.
+	Match(methods []string, path string, handlers ...fiber.Handler) MultiSwagger
+
+	// Group automatically create tags for the swagger documentation.
+	//
+	// Group creates a new router group with prefix and optional group-level middleware.
+	Group(prefix string, handlers ...fiber.Handler) FiberRouter
+}
@@ -35,11 +35,21 @@ type GinRouter interface {
 
 	// HEAD is a shortcut for router.Handle("HEAD", path, handlers).
 	HEAD(path string, h ...gin.HandlerFunc) Swagger
+
+	// Any registers a route that matches all the HTTP methods, it is documented for
+	// GET, POST, PUT, PATCH, DELETE, HEAD and OPTIONS.
+	Any(path string, h ...gin.HandlerFunc) MultiSwagger
+
+	// Match registers a route that matches the specified methods that you declared,
+	// it is documented once per method.
+	Match(methods []string, path string, h ...gin.HandlerFunc) MultiSwagger
 }
 
 type GinGroup interface {
+	GinRouter
 	// Group automatically create tags for the swagger documentation.
 	//
 	// Group creates a new router group with prefix and optional group-level middleware.
-	Group(prefix string, h ...gin.HandlerFunc) GinRouter
+	// The groups can be nested, the prefixes and the middlewares of the parent groups are inherited.
+	Group(prefix string, h ...gin.HandlerFunc) GinGroup
 }
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+type GorillaRouter interface {
+	// Use appends middlewares to the chain of the router, they run after a route matches.
+	Use(middlewares ...mux.MiddlewareFunc)
+
+	// Handle registers a new route with a matcher for the path.
+	// The route is documented once its http methods are set with Methods.
+	Handle(path string, h http.Handler) GorillaRoute
+
+	// HandleFunc registers a new route with a matcher for the path.
+	// The route is documented once its http methods are set with Methods.
+	HandleFunc(path string, f func(http.ResponseWriter, *http.Request)) GorillaRoute
+
+	// Any registers a route for every http method, it is documented for the methods GET, POST, PUT,
+	// PATCH, DELETE, HEAD and OPTIONS.
+	Any(path string, f func(http.ResponseWriter, *http.Request)) MultiSwagger
+
+	// Match registers a route for the http methods, it is documented once per method.
+	Match(methods []string, path string, f func(http.ResponseWriter, *http.Request)) MultiSwagger
+
+	// PathPrefix registers a new route with a matcher for the path prefix.
+	// Its Subrouter automatically creates tags for the swagger documentation.
+	PathPrefix(tpl string) GorillaPrefix
+}
+
+type GorillaRoute interface {
+	// Methods adds a matcher for the http methods and documents one operation per method,
+	// the annotations of the returned Swagger are applied to all of them.
+	Methods(methods ...string) MultiSwagger
+}
+
+type GorillaPrefix interface {
+	// Subrouter creates a router for the prefix, its routes are prefixed and tagged by it.
+	Subrouter() GorillaRouter
+
+	// Handler sets the handler of the prefix, e.g. a file server. It is not documented.
+	Handler(h http.Handler)
+
+	// HandlerFunc sets the handler function of the prefix. It is not documented.
+	HandlerFunc(f func(http.ResponseWriter, *http.Request))
+}
@@ -4,7 +4,8 @@ import "net/http"
 
 type HTTPRouter interface {
 	// Handle registers a new request handle and middleware with the given path and method.
-	// The last handler should be the real handler, the other ones should be middleware that can and should be shared among different routes.
+	// The last handler should be the real handler, the other ones run before it and the first one
+	// that writes a response stops the others. Use With for standard func(http.Handler) http.Handler middlewares.
 	//
 	// For GET, POST, PUT, PATCH and DELETE requests the respective shortcut
 	// functions can be used.
@@ -34,11 +35,29 @@ type HTTPRouter interface {
 
 	// HEAD is a shortcut for router.Handle("HEAD", path, handlers).
 	HEAD(path string, h ...http.HandlerFunc) Swagger
+
+	// Any registers the handlers for every http method, it is documented for the methods
+	// GET, POST, PUT, PATCH, DELETE, HEAD and OPTIONS.
+	Any(path string, h ...http.HandlerFunc) MultiSwagger
+
+	// Match registers the handlers for the http methods, it is documented once per method.
+	Match(methods []string, path string, h ...http.HandlerFunc) MultiSwagger
+
+	// With returns a router whose routes are wrapped by the route-level middlewares.
+	// The middlewares are standard func(http.Handler) http.Handler, the first one is the outermost and any of them
+	// can stop the request by not calling the next handler. The routes are documented with the tags of the router.
+	With(middlewares ...func(http.Handler) http.Handler) HTTPRouter
 }
 
 type HTTPGroup interface {
+	HTTPRouter
+	// Use appends middlewares that wrap the routes and groups registered afterwards,
+	// they run before the middlewares of the groups and of the routes.
+	Use(middlewares ...func(http.Handler) http.Handler)
+
 	// Group automatically create tags for the swagger documentation.
 	//
-	// Group creates a new router group with prefix and optional group-level middleware.
-	Group(prefix string, h ...http.HandlerFunc) HTTPRouter
+	// Group creates a new router group with prefix and optional group-level middlewares that wrap every route of the group.
+	// The groups can be nested, the prefixes and the middlewares of the parent groups are inherited.
+	Group(prefix string, middlewares ...func(http.Handler) http.Handler) HTTPGroup
 }
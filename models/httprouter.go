@@ -0,0 +1,51 @@
+package models
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+type HttprouterRouter interface {
+	// GET is a shortcut for router.Handle(http.MethodGet, path, handle).
+	GET(path string, handle httprouter.Handle) Swagger
+
+	// POST is a shortcut for router.Handle(http.MethodPost, path, handle).
+	POST(path string, handle httprouter.Handle) Swagger
+
+	// PUT is a shortcut for router.Handle(http.MethodPut, path, handle).
+	PUT(path string, handle httprouter.Handle) Swagger
+
+	// DELETE is a shortcut for router.Handle(http.MethodDelete, path, handle).
+	DELETE(path string, handle httprouter.Handle) Swagger
+
+	// PATCH is a shortcut for router.Handle(http.MethodPatch, path, handle).
+	PATCH(path string, handle httprouter.Handle) Swagger
+
+	// OPTIONS is a shortcut for router.Handle(http.MethodOptions, path, handle).
+	OPTIONS(path string, handle httprouter.Handle) Swagger
+
+	// HEAD is a shortcut for router.Handle(http.MethodHead, path, handle).
+	HEAD(path string, handle httprouter.Handle) Swagger
+
+	// Handle registers a new request handle with the given path and method.
+	// The named parameters :name and the catch-all parameters *name are documented as {name}.
+	Handle(method, path string, handle httprouter.Handle) Swagger
+
+	// Handler is an adapter which allows the usage of an http.Handler as a request handle.
+	Handler(method, path string, handler http.Handler) Swagger
+
+	// HandlerFunc is an adapter which allows the usage of an http.HandlerFunc as a request handle.
+	HandlerFunc(method, path string, handler http.HandlerFunc) Swagger
+
+	// Any registers the handle for the http methods GET, POST, PUT, PATCH, DELETE, HEAD and OPTIONS,
+	// it is documented once per method.
+	Any(path string, handle httprouter.Handle) MultiSwagger
+
+	// Match registers the handle for the http methods, it is documented once per method.
+	Match(methods []string, path string, handle httprouter.Handle) MultiSwagger
+
+	// ServeFiles serves files from the given file system root, the path must end with /*filepath.
+	// It is not documented.
+	ServeFiles(path string, root http.FileSystem)
+}
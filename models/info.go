@@ -0,0 +1,84 @@
+package models
+
+// Info is the general information of the API, written in the generated documentation
+// instead of the comments above main().
+type Info struct {
+	Title          string
+	Version        string
+	Description    string
+	TermsOfService string
+	Contact        *Contact
+	License        *License
+
+	// Host, BasePath and Schemes define where the API is served (Swagger 2.0),
+	// e.g. "api.example.com", "/v1" and []string{"https"}.
+	Host     string
+	BasePath string
+	Schemes  []string
+
+	// Servers define where the API is served (OpenAPI 3.1).
+	// When empty, a server is derived from Host, BasePath and Schemes.
+	Servers []Server
+
+	// SecuritySchemes are the schemes referenced by the routes with Security, keyed by name.
+	SecuritySchemes map[string]SecurityScheme
+}
+
+type Contact struct {
+	Name  string
+	URL   string
+	Email string
+}
+
+type License struct {
+	Name string
+	URL  string
+}
+
+type Server struct {
+	URL         string
+	Description string
+}
+
+// SecuritySchemeType is the kind of authentication of a security scheme.
+type SecuritySchemeType string
+
+const (
+	// SecurityBearer is a bearer token sent in the Authorization header.
+	SecurityBearer SecuritySchemeType = "bearer"
+	// SecurityAPIKey is a key sent in a header, query parameter or cookie.
+	SecurityAPIKey SecuritySchemeType = "apiKey"
+	// SecurityBasic is the basic http authentication.
+	SecurityBasic SecuritySchemeType = "basic"
+	// SecurityOAuth2 is an OAuth2 flow.
+	SecurityOAuth2 SecuritySchemeType = "oauth2"
+)
+
+// OAuth2Flow is the flow of an OAuth2 security scheme, named as in OpenAPI 3.
+type OAuth2Flow string
+
+const (
+	OAuth2Implicit          OAuth2Flow = "implicit"
+	OAuth2Password          OAuth2Flow = "password"
+	OAuth2ClientCredentials OAuth2Flow = "clientCredentials"
+	OAuth2AuthorizationCode OAuth2Flow = "authorizationCode"
+)
+
+type SecurityScheme struct {
+	Type        SecuritySchemeType
+	Description string
+
+	// Name and In are the name and the location (header, query or cookie) of an api key.
+	Name string
+	In   string
+
+	// BearerFormat is a hint of the format of a bearer token, e.g. JWT.
+	BearerFormat string
+
+	// Flow, AuthorizationURL, TokenURL and Scopes describe an OAuth2 scheme,
+	// the scopes are keyed by name with their description.
+	Flow             OAuth2Flow
+	AuthorizationURL string
+	TokenURL         string
+	Scopes           map[string]string
+}
@@ -75,20 +75,94 @@ type Swagger interface {
 	// QueryParam is used to define the query parameters of the route and if it is required or not.
 	// The dataType field should be one of the following options:
 	// goswag.StringType, goswag.IntType, goswag.NumberType, goswag.BoolType.
-	QueryParam(name, description, dataType string, required bool) Swagger
+	// The options constrain the values of the parameter, e.g. goswag.Enum, goswag.Default or goswag.Array:
+	//
+	//	QueryParam("limit", "page size", goswag.IntType, false, goswag.Default(20), goswag.Minimum(1), goswag.Maximum(100))
+	QueryParam(name, description, dataType string, required bool, opts ...ParamOption) Swagger
 
 	// HeaderParam is used to define the header parameters of the route and if it is required or not.
 	// The dataType field should be one of the following options:
 	// goswag.StringType, goswag.IntType, goswag.NumberType, goswag.BoolType.
-	HeaderParam(name, description, dataType string, required bool) Swagger
+	// The options constrain the values of the parameter like in QueryParam.
+	HeaderParam(name, description, dataType string, required bool, opts ...ParamOption) Swagger
 
 	// PathParam is used to define the path parameters of the route and if it is required or not.
+	// The parameters of the path are declared as required strings when the route is registered,
+	// PathParam replaces the one with the same name.
 	// The dataType field should be one of the following options:
 	// goswag.StringType, goswag.IntType, goswag.NumberType, goswag.BoolType.
-	PathParam(name, description, dataType string, required bool) Swagger
+	// The options constrain the values of the parameter like in QueryParam.
+	PathParam(name, description, dataType string, required bool, opts ...ParamOption) Swagger
+
+	// CookieParam is used to define the cookies of the route and if they are required or not.
+	// The dataType and the options are the ones of QueryParam.
+	CookieParam(name, description, dataType string, required bool, opts ...ParamOption) Swagger
+
+	// FormParam is used to define the fields of a form sent as request body and if they are required or not.
+	// The dataType and the options are the ones of QueryParam. The route consumes
+	// application/x-www-form-urlencoded, or multipart/form-data when it has files or Accepts says so.
+	// The request body of Read can not be combined with form parameters: GenerateSwaggerE reports it
+	// and the other generators only document the form.
+	FormParam(name, description, dataType string, required bool, opts ...ParamOption) Swagger
+
+	// FileParam is used to define a file of a multipart/form-data request body and if it is required or not.
+	// goswag.MultipleFiles accepts several files, goswag.MimeTypes restricts their content types
+	// and goswag.MaxSize their size:
+	//
+	//	FileParam("photos", "album photos", true, goswag.MultipleFiles(), goswag.MimeTypes("image/*"), goswag.MaxSize(10<<20))
+	FileParam(name, description string, required bool, opts ...ParamOption) Swagger
+
+	// Params declares the query, header and path parameters of the tagged fields of a struct at once,
+	// v is a struct or a pointer to one and the types are inferred from the fields.
+	// The location and name come from the query, header or path tags, gin's form and uri tags
+	// and echo's param tag are understood as well. The tags required:"true", doc:"description",
+	// default:"value" and enum:"a,b" complete the declaration, as well as the example, format, minimum,
+	// maximum, minLength, maxLength, pattern, collectionFormat and deprecated:"true" tags.
+	// Slices are arrays and embedded structs are read too.
+	// Example:
+	//
+	//	type ListUsers struct {
+	//		Page   int    `query:"page" doc:"page number" default:"1"`
+	//		Status string `query:"status" enum:"active,blocked"`
+	//		Tenant string `header:"X-Tenant" required:"true"`
+	//	}
+	//
+	//	ge.GET("/users", listUsers).Params(ListUsers{})
+	Params(v any) Swagger
 
 	// Security adds one or more security requirements to the route.
 	// Each item should match a security scheme name defined in your docs
 	// (e.g., "BearerAuth"), so swag can generate an Authorize button.
 	Security(schemes ...string) Swagger
 }
+
+// MultiSwagger documents a route registered for several methods with Any or Match, one operation per method.
+// The annotations are applied to every operation when they are called, Method returns the operation of a single
+// method so its annotations can be overridden afterwards:
+//
+//	r := ge.Match([]string{"GET", "HEAD"}, "/files/:name", handleFile)
+//	r.Summary("Get a file").PathParam("name", "file name", goswag.StringType, true)
+//	r.Method("HEAD").Summary("Check a file")
+type MultiSwagger interface {
+	Swagger
+
+	// Method returns the operation of the http method. The annotations of a method that
+	// was not registered are not documented.
+	Method(method string) Swagger
+}
+
+// Docs holds the documentation methods shared by all the frameworks.
+type Docs interface {
+	// SetInfo sets the general information of the API written in every generated or served document.
+	SetInfo(info Info)
+	// GenerateSwagger writes the goswag.go file, logs the invalid annotations and calls log.Fatal when it fails.
+	GenerateSwagger()
+	// GenerateSwaggerE generates the documentation as configured by the options and returns its errors.
+	GenerateSwaggerE(opts GenerateOptions) error
+	// GenerateOpenAPI writes an openapi.json file (OpenAPI 3.1) without the need of the swag binary.
+	GenerateOpenAPI()
+	// OpenAPI returns the json of the OpenAPI 3.1 document of the routes registered so far.
+	OpenAPI() ([]byte, error)
+	// ServeDocs mounts Swagger UI, Redoc and the live OpenAPI document on prefix, relative to the group if any.
+	ServeDocs(prefix string)
+}
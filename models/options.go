@@ -0,0 +1,51 @@
+package models
+
+import "io"
+
+// Logger receives the progress messages of the generation.
+// *log.Logger and most structured loggers adapters implement it.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// OutputFormat defines which kind of file is generated.
+type OutputFormat int
+
+const (
+	// FormatSwag generates the goswag.go file with the comments read by the swag binary.
+	FormatSwag OutputFormat = iota
+	// FormatOpenAPI generates an OpenAPI 3.1 document without the need of the swag binary.
+	FormatOpenAPI
+	// FormatSwagger2JSON generates a Swagger 2.0 swagger.json document without the need of the swag binary.
+	FormatSwagger2JSON
+	// FormatSwagger2YAML generates a Swagger 2.0 swagger.yaml document without the need of the swag binary.
+	FormatSwagger2YAML
+)
+
+// GenerateOptions configures the generation of the documentation.
+type GenerateOptions struct {
+	// Format is the kind of file that will be generated, the default is FormatSwag.
+	Format OutputFormat
+
+	// Logger receives the progress messages, nothing is logged if it is nil.
+	Logger Logger
+
+	// OutputDir is the directory where the file is written, the default is the current directory.
+	// It is created if it does not exist.
+	OutputDir string
+
+	// FileName is the name of the generated file,
+	// the default is goswag.go for FormatSwag, openapi.json for FormatOpenAPI
+	// and swagger.json or swagger.yaml for the Swagger 2.0 formats.
+	FileName string
+
+	// PackageName is the package of the generated goswag.go file, the default is main.
+	PackageName string
+
+	// Info is the general information of the API written in the documentation.
+	// The info set on the router with SetInfo is used when it is nil.
+	Info *Info
+
+	// Writer receives the generated content instead of a file, OutputDir and FileName are ignored when it is set.
+	Writer io.Writer
+}
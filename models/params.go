@@ -0,0 +1,36 @@
+package models
+
+// ParamOptions are the constraints of a parameter, set with ParamOption values
+// such as goswag.Enum or goswag.Minimum. The values are kept as they are written in the swag comments
+// and converted to the type of the parameter in the OpenAPI and Swagger 2.0 documents.
+type ParamOptions struct {
+	// Enum are the values accepted by the parameter.
+	Enum []string
+	// Default is the value used when the parameter is not sent.
+	Default string
+	// Example is an example value of the parameter.
+	Example string
+	// Format refines the type of the parameter, e.g. uuid, date-time, int64 or email.
+	Format string
+	// Minimum and Maximum are the inclusive bounds of a numeric parameter.
+	Minimum *float64
+	Maximum *float64
+	// MinLength and MaxLength are the bounds of the length of a string parameter.
+	MinLength *int
+	MaxLength *int
+	// Pattern is a regular expression the value must match.
+	Pattern string
+	// Array makes the parameter a list of values of its type, the constraints apply to each of them.
+	Array bool
+	// CollectionFormat is how the values of an array are sent: csv (the default), ssv, tsv, pipes or multi.
+	CollectionFormat string
+	// Deprecated marks the parameter as deprecated.
+	Deprecated bool
+	// MimeTypes are the content types accepted for a file, e.g. image/png or image/*.
+	MimeTypes []string
+	// MaxSize is the maximum size of a file in bytes.
+	MaxSize int64
+}
+
+// ParamOption sets a constraint of a parameter.
+type ParamOption func(o *ParamOptions)
@@ -0,0 +1,38 @@
+package models
+
+import "net/http"
+
+// ValidationError describes one problem found while validating a request.
+type ValidationError struct {
+	// In is where the problem was found: path, query, header, cookie, form or body.
+	In string `json:"in"`
+	// Name is the parameter name or, for the body, the json path of the invalid field.
+	Name   string `json:"name,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// ValidationProblem is the problem details (RFC 9457) of a request that does not match its documentation.
+type ValidationProblem struct {
+	Type   string            `json:"type"`
+	Title  string            `json:"title"`
+	Status int               `json:"status"`
+	Detail string            `json:"detail,omitempty"`
+	Errors []ValidationError `json:"errors"`
+}
+
+// DefaultMaxBodySize is the maximum size of the json bodies read by the validation middlewares.
+const DefaultMaxBodySize = 10 << 20
+
+// ValidationOptions configures the validation middlewares.
+type ValidationOptions struct {
+	// Status is the status code of the problem response, the default is 400.
+	Status int
+
+	// MaxBodySize is the maximum size in bytes of the json bodies, the larger ones are rejected
+	// without being read entirely. The default is DefaultMaxBodySize.
+	MaxBodySize int64
+
+	// ProblemHandler writes the response of invalid requests.
+	// The default writes the problem as application/problem+json.
+	ProblemHandler func(w http.ResponseWriter, r *http.Request, problem ValidationProblem)
+}
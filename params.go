@@ -0,0 +1,99 @@
+package goswag
+
+import (
+	"fmt"
+
+	"github.com/r0bertson/goswag/models"
+)
+
+// These are the collection formats of the array parameters.
+const (
+	CollectionCSV   = "csv"
+	CollectionSSV   = "ssv"
+	CollectionTSV   = "tsv"
+	CollectionPipes = "pipes"
+	CollectionMulti = "multi"
+)
+
+// Enum restricts the parameter to the values.
+//
+//	ge.GET("/users", listUsers).QueryParam("status", "user status", goswag.StringType, false, goswag.Enum("active", "blocked"))
+func Enum(values ...any) models.ParamOption {
+	return func(o *models.ParamOptions) {
+		o.Enum = nil
+		for _, v := range values {
+			o.Enum = append(o.Enum, fmt.Sprint(v))
+		}
+	}
+}
+
+// Default sets the value used when the parameter is not sent.
+func Default(value any) models.ParamOption {
+	return func(o *models.ParamOptions) { o.Default = fmt.Sprint(value) }
+}
+
+// Example sets an example value of the parameter.
+func Example(value any) models.ParamOption {
+	return func(o *models.ParamOptions) { o.Example = fmt.Sprint(value) }
+}
+
+// Format refines the type of the parameter, e.g. uuid, date-time, int64 or email.
+func Format(format string) models.ParamOption {
+	return func(o *models.ParamOptions) { o.Format = format }
+}
+
+// Minimum sets the inclusive lower bound of a numeric parameter.
+func Minimum(min float64) models.ParamOption {
+	return func(o *models.ParamOptions) { o.Minimum = &min }
+}
+
+// Maximum sets the inclusive upper bound of a numeric parameter.
+func Maximum(max float64) models.ParamOption {
+	return func(o *models.ParamOptions) { o.Maximum = &max }
+}
+
+// MinLength sets the minimum length of a string parameter.
+func MinLength(n int) models.ParamOption {
+	return func(o *models.ParamOptions) { o.MinLength = &n }
+}
+
+// MaxLength sets the maximum length of a string parameter.
+func MaxLength(n int) models.ParamOption {
+	return func(o *models.ParamOptions) { o.MaxLength = &n }
+}
+
+// Pattern sets a regular expression the value must match, swag does not support it in the comments.
+func Pattern(pattern string) models.ParamOption {
+	return func(o *models.ParamOptions) { o.Pattern = pattern }
+}
+
+// Array makes the parameter a list of values of its type sent with the collection format,
+// one of CollectionCSV, CollectionSSV, CollectionTSV, CollectionPipes or CollectionMulti.
+// The other options constrain each value.
+//
+//	ge.GET("/users", listUsers).QueryParam("id", "user ids", goswag.IntType, false, goswag.Array(goswag.CollectionMulti))
+func Array(collectionFormat string) models.ParamOption {
+	return func(o *models.ParamOptions) { o.Array, o.CollectionFormat = true, collectionFormat }
+}
+
+// Deprecated marks the parameter as deprecated, Swagger 2.0 and swag do not support it.
+func Deprecated() models.ParamOption {
+	return func(o *models.ParamOptions) { o.Deprecated = true }
+}
+
+// MultipleFiles allows several files to be sent in a file parameter.
+func MultipleFiles() models.ParamOption {
+	return func(o *models.ParamOptions) { o.Array, o.CollectionFormat = true, CollectionMulti }
+}
+
+// MimeTypes restricts the content types of the files, a type like image/* accepts all the images.
+//
+//	gg.POST("/avatar", uploadAvatar).FileParam("avatar", "user avatar", true, goswag.MimeTypes("image/png", "image/jpeg"), goswag.MaxSize(5<<20))
+func MimeTypes(mimeTypes ...string) models.ParamOption {
+	return func(o *models.ParamOptions) { o.MimeTypes = mimeTypes }
+}
+
+// MaxSize sets the maximum size of the files in bytes.
+func MaxSize(bytes int64) models.ParamOption {
+	return func(o *models.ParamOptions) { o.MaxSize = bytes }
+}
@@ -0,0 +1,101 @@
+package goswag
+
+import (
+	"context"
+	"net/http"
+
+	echoWrapper "github.com/r0bertson/goswag/internal/frameworks/echo"
+	ginWrapper "github.com/r0bertson/goswag/internal/frameworks/gin"
+	httpWrapper "github.com/r0bertson/goswag/internal/frameworks/http"
+	"github.com/r0bertson/goswag/models"
+)
+
+// Handler is a typed handler: the request is decoded into Req and the returned Resp is written as json.
+// Return a *models.StatusError to answer with its status code, the other errors are answered with 500.
+//
+// The typed handlers are registered with the functions of the framework of the router, e.g. GinPost:
+// the routes of POST, PUT and PATCH read Req as json body, POST returns Resp with 201 and the other
+// methods with 200. Use struct{} as Req or Resp when there is no request or response body.
+type Handler[Req, Resp any] func(ctx context.Context, req Req) (Resp, error)
+
+// EchoGet registers a typed handler for GET requests on a router returned by NewEcho, NewEchoFrom
+// or NewEchoGroup, or one of their groups. The request is bound with c.Bind, from the path and the query.
+func EchoGet[Req, Resp any](router models.EchoRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return echoWrapper.Handle(router, http.MethodGet, path, h)
+}
+
+// EchoPost registers a typed handler for POST requests on an echo router.
+//
+//	goswag.EchoPost(ge, "/users", func(ctx context.Context, req CreateUser) (User, error) {
+//		return users.Create(ctx, req)
+//	}).Summary("Create a user")
+func EchoPost[Req, Resp any](router models.EchoRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return echoWrapper.Handle(router, http.MethodPost, path, h)
+}
+
+// EchoPut registers a typed handler for PUT requests on an echo router.
+func EchoPut[Req, Resp any](router models.EchoRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return echoWrapper.Handle(router, http.MethodPut, path, h)
+}
+
+// EchoPatch registers a typed handler for PATCH requests on an echo router.
+func EchoPatch[Req, Resp any](router models.EchoRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return echoWrapper.Handle(router, http.MethodPatch, path, h)
+}
+
+// EchoDelete registers a typed handler for DELETE requests on an echo router.
+func EchoDelete[Req, Resp any](router models.EchoRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return echoWrapper.Handle(router, http.MethodDelete, path, h)
+}
+
+// GinGet registers a typed handler for GET requests on a router returned by NewGin, or one of its groups.
+// The request is bound from the query.
+func GinGet[Req, Resp any](router models.GinRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return ginWrapper.Handle(router, http.MethodGet, path, h)
+}
+
+// GinPost registers a typed handler for POST requests on a gin router.
+func GinPost[Req, Resp any](router models.GinRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return ginWrapper.Handle(router, http.MethodPost, path, h)
+}
+
+// GinPut registers a typed handler for PUT requests on a gin router.
+func GinPut[Req, Resp any](router models.GinRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return ginWrapper.Handle(router, http.MethodPut, path, h)
+}
+
+// GinPatch registers a typed handler for PATCH requests on a gin router.
+func GinPatch[Req, Resp any](router models.GinRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return ginWrapper.Handle(router, http.MethodPatch, path, h)
+}
+
+// GinDelete registers a typed handler for DELETE requests on a gin router.
+func GinDelete[Req, Resp any](router models.GinRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return ginWrapper.Handle(router, http.MethodDelete, path, h)
+}
+
+// HTTPGet registers a typed handler for GET requests on a router returned by NewHTTP, or one of its groups.
+// The fields of the request with a path, query or header tag are bound from the request.
+func HTTPGet[Req, Resp any](router models.HTTPRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return httpWrapper.Handle(router, http.MethodGet, path, h)
+}
+
+// HTTPPost registers a typed handler for POST requests on a net/http router.
+func HTTPPost[Req, Resp any](router models.HTTPRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return httpWrapper.Handle(router, http.MethodPost, path, h)
+}
+
+// HTTPPut registers a typed handler for PUT requests on a net/http router.
+func HTTPPut[Req, Resp any](router models.HTTPRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return httpWrapper.Handle(router, http.MethodPut, path, h)
+}
+
+// HTTPPatch registers a typed handler for PATCH requests on a net/http router.
+func HTTPPatch[Req, Resp any](router models.HTTPRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return httpWrapper.Handle(router, http.MethodPatch, path, h)
+}
+
+// HTTPDelete registers a typed handler for DELETE requests on a net/http router.
+func HTTPDelete[Req, Resp any](router models.HTTPRouter, path string, h Handler[Req, Resp]) models.Swagger {
+	return httpWrapper.Handle(router, http.MethodDelete, path, h)
+}